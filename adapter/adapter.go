@@ -0,0 +1,107 @@
+package adapter
+
+import "github.com/disksing/iter/v2"
+
+// Stack is a last-in, first-out container adapter.
+type Stack[T any] struct {
+	s StackStorage[T]
+}
+
+// NewStack returns a stack that keeps elements in s, with the back of s being
+// the top. If s is nil, a SliceStorage is used.
+func NewStack[T any](s StackStorage[T]) *Stack[T] {
+	if s == nil {
+		s = &SliceStorage[T]{}
+	}
+	return &Stack[T]{s: s}
+}
+
+// MakeStack returns a stack that keeps elements in s, and pushes the elements
+// in the range [first, last) to it. If s is nil, a SliceStorage is used.
+func MakeStack[T any, It iter.InputIter[T, It]](first, last It, s StackStorage[T]) *Stack[T] {
+	st := NewStack(s)
+	for ; !first.Eq(last); first = first.Next() {
+		st.Push(first.Read())
+	}
+	return st
+}
+
+// Len returns the number of elements.
+func (st *Stack[T]) Len() int {
+	return st.s.Len()
+}
+
+// Push adds v to the top.
+func (st *Stack[T]) Push(v T) {
+	st.s.PushBack(v)
+}
+
+// Pop removes the top element and returns it. The stack must not be empty.
+func (st *Stack[T]) Pop() T {
+	return st.s.PopBack()
+}
+
+// Peek returns the top element. The stack must not be empty.
+func (st *Stack[T]) Peek() T {
+	return st.s.Back()
+}
+
+// Queue is a first-in, first-out container adapter.
+type Queue[T any] struct {
+	s QueueStorage[T]
+}
+
+// NewQueue returns a queue that keeps elements in s, with elements pushed to
+// the back of s and popped from the front. If s is nil, a DequeStorage is
+// used.
+func NewQueue[T any](s QueueStorage[T]) *Queue[T] {
+	if s == nil {
+		s = &DequeStorage[T]{}
+	}
+	return &Queue[T]{s: s}
+}
+
+// MakeQueue returns a queue that keeps elements in s, and pushes the elements
+// in the range [first, last) to it. If s is nil, a DequeStorage is used.
+func MakeQueue[T any, It iter.InputIter[T, It]](first, last It, s QueueStorage[T]) *Queue[T] {
+	q := NewQueue(s)
+	for ; !first.Eq(last); first = first.Next() {
+		q.Push(first.Read())
+	}
+	return q
+}
+
+// Len returns the number of elements.
+func (q *Queue[T]) Len() int {
+	return q.s.Len()
+}
+
+// Push adds v to the back.
+func (q *Queue[T]) Push(v T) {
+	q.s.PushBack(v)
+}
+
+// Pop removes the front element and returns it. The queue must not be empty.
+func (q *Queue[T]) Pop() T {
+	return q.s.PopFront()
+}
+
+// Peek returns the front element. The queue must not be empty.
+func (q *Queue[T]) Peek() T {
+	return q.s.Front()
+}
+
+// Drain pops all elements of a Stack or Queue, writes them to dFirst in the
+// popped order, and returns the output iterator past the last written one.
+func Drain[T any, Out iter.OutputIter[T]](c interface {
+	Pop() T
+	Len() int
+}, dFirst Out) Out {
+	for c.Len() > 0 {
+		dFirst.Write(c.Pop())
+		if inc, ok := any(dFirst).(iter.ForwardMovable[Out]); ok {
+			dFirst = inc.Next()
+		}
+	}
+	return dFirst
+}
@@ -0,0 +1,111 @@
+package adapter_test
+
+import (
+	"testing"
+
+	. "github.com/disksing/iter/v2/adapter"
+	"github.com/disksing/iter/v2/lists"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ StackStorage[int] = &SliceStorage[int]{}
+	_ StackStorage[int] = &DequeStorage[int]{}
+	_ QueueStorage[int] = &DequeStorage[int]{}
+	_ StackStorage[int] = ListStorage[int]{}
+	_ QueueStorage[int] = ListStorage[int]{}
+)
+
+func TestStack(t *testing.T) {
+	assert := assert.New(t)
+	for _, s := range []StackStorage[int]{nil, &DequeStorage[int]{}, OnList(lists.NewList[int]())} {
+		st := NewStack(s)
+		st.Push(1)
+		st.Push(2)
+		st.Push(3)
+		assert.Equal(3, st.Len())
+		assert.Equal(3, st.Peek())
+		assert.Equal(3, st.Pop())
+		assert.Equal(2, st.Pop())
+		st.Push(4)
+		var out []int
+		Drain[int](st, slices.Appender(&out))
+		assert.Equal([]int{4, 1}, out)
+		assert.Equal(0, st.Len())
+	}
+	assert.Panics(func() { NewStack[int](nil).Pop() })
+}
+
+func TestQueue(t *testing.T) {
+	assert := assert.New(t)
+	for _, s := range []QueueStorage[int]{nil, OnList(lists.NewList[int]())} {
+		q := NewQueue(s)
+		for i := 1; i <= 20; i++ {
+			q.Push(i)
+			if i%3 == 0 {
+				assert.Equal(i/3, q.Pop())
+			}
+		}
+		assert.Equal(14, q.Len())
+		assert.Equal(7, q.Peek())
+		out := make([]int, 14)
+		Drain[int](q, slices.Begin(out))
+		assert.Equal([]int{7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, out)
+	}
+	assert.Panics(func() { NewQueue[int](nil).Peek() })
+}
+
+func TestEmpty(t *testing.T) {
+	assert := assert.New(t)
+	for _, s := range []StackStorage[int]{&SliceStorage[int]{}, &DequeStorage[int]{}, OnList(lists.NewList[int]())} {
+		st := NewStack(s)
+		assert.Panics(func() { st.Pop() }, "%T", s)
+		assert.Panics(func() { st.Peek() }, "%T", s)
+		st.Push(1)
+		st.Pop()
+		assert.Panics(func() { st.Pop() }, "%T", s)
+	}
+	for _, s := range []QueueStorage[int]{&DequeStorage[int]{}, OnList(lists.NewList[int]())} {
+		q := NewQueue(s)
+		assert.Panics(func() { q.Pop() }, "%T", s)
+		assert.Panics(func() { q.Peek() }, "%T", s)
+		q.Push(1)
+		q.Pop()
+		assert.Panics(func() { q.Pop() }, "%T", s)
+	}
+}
+
+func TestMake(t *testing.T) {
+	assert := assert.New(t)
+	in := []int{1, 2, 3}
+	st := MakeStack[int](slices.Begin(in), slices.End(in), nil)
+	assert.Equal(3, st.Peek())
+	q := MakeQueue[int](slices.Begin(in).Next(), slices.End(in), nil)
+	assert.Equal(2, q.Peek())
+	assert.Equal(2, q.Len())
+
+	l := lists.NewList(0)
+	MakeQueue[int](slices.Begin(in), slices.End(in), OnList(l))
+	assert.Equal(4, l.Len())
+}
+
+func TestDeque(t *testing.T) {
+	assert := assert.New(t)
+	var d DequeStorage[int]
+	for i := 0; i < 10; i++ {
+		d.PushFront(-i)
+		d.PushBack(i)
+	}
+	assert.Equal(20, d.Len())
+	assert.Equal(-9, d.Front())
+	assert.Equal(9, d.Back())
+	assert.Equal(-9, d.PopFront())
+	assert.Equal(9, d.PopBack())
+	assert.Equal(18, d.Len())
+	for d.Len() > 1 {
+		d.PopBack()
+	}
+	assert.Equal(-8, d.PopFront())
+	assert.Panics(func() { d.Back() })
+}
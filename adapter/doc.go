@@ -0,0 +1,7 @@
+// Package adapter provides Stack and Queue, container adapters like C++
+// std::stack and std::queue.
+//
+// An adapter restricts an underlying storage to the operations of a stack or
+// a queue. Any type that implements StackStorage or QueueStorage can be used;
+// SliceStorage, DequeStorage, and ListStorage are provided.
+package adapter
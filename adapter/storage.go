@@ -0,0 +1,161 @@
+package adapter
+
+import "github.com/disksing/iter/v2/lists"
+
+// StackStorage is the storage required by a Stack.
+type StackStorage[T any] interface {
+	PushBack(v T)
+	PopBack() T
+	Back() T
+	Len() int
+}
+
+// QueueStorage is the storage required by a Queue.
+type QueueStorage[T any] interface {
+	PushBack(v T)
+	PopFront() T
+	Front() T
+	Len() int
+}
+
+// SliceStorage is a StackStorage backed by a slice. The zero value is empty
+// and ready to use.
+type SliceStorage[T any] struct {
+	s []T
+}
+
+func (s *SliceStorage[T]) PushBack(v T) {
+	s.s = append(s.s, v)
+}
+
+func (s *SliceStorage[T]) PopBack() T {
+	n := len(s.s) - 1
+	v := s.s[n]
+	var zero T
+	s.s[n] = zero
+	s.s = s.s[:n]
+	return v
+}
+
+func (s *SliceStorage[T]) Back() T {
+	return s.s[len(s.s)-1]
+}
+
+func (s *SliceStorage[T]) Len() int {
+	return len(s.s)
+}
+
+// DequeStorage is a StackStorage and QueueStorage backed by a circular slice
+// that grows as needed. The zero value is empty and ready to use.
+type DequeStorage[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+func (d *DequeStorage[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *DequeStorage[T]) grow() {
+	buf := make([]T, max(2*len(d.buf), 8))
+	for i := 0; i < d.n; i++ {
+		buf[i] = d.buf[d.index(i)]
+	}
+	d.buf, d.head = buf, 0
+}
+
+// PushFront inserts v at the front.
+func (d *DequeStorage[T]) PushFront(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.n++
+}
+
+func (d *DequeStorage[T]) PushBack(v T) {
+	if d.n == len(d.buf) {
+		d.grow()
+	}
+	d.buf[d.index(d.n)] = v
+	d.n++
+}
+
+func (d *DequeStorage[T]) PopFront() T {
+	v := d.Front()
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.n--
+	return v
+}
+
+func (d *DequeStorage[T]) PopBack() T {
+	v := d.Back()
+	var zero T
+	d.buf[d.index(d.n-1)] = zero
+	d.n--
+	return v
+}
+
+func (d *DequeStorage[T]) Front() T {
+	if d.n == 0 {
+		panic("adapter: empty deque")
+	}
+	return d.buf[d.head]
+}
+
+func (d *DequeStorage[T]) Back() T {
+	if d.n == 0 {
+		panic("adapter: empty deque")
+	}
+	return d.buf[d.index(d.n-1)]
+}
+
+func (d *DequeStorage[T]) Len() int {
+	return d.n
+}
+
+// ListStorage is a StackStorage and QueueStorage backed by a lists.List.
+type ListStorage[T any] struct {
+	l *lists.List[T]
+}
+
+// OnList returns a storage that keeps elements in l.
+func OnList[T any](l *lists.List[T]) ListStorage[T] {
+	return ListStorage[T]{l: l}
+}
+
+func (s ListStorage[T]) PushBack(v T) {
+	s.l.PushBack(v)
+}
+
+func (s ListStorage[T]) PopBack() T {
+	return s.l.PopBack()
+}
+
+func (s ListStorage[T]) PopFront() T {
+	return s.l.PopFront()
+}
+
+func (s ListStorage[T]) Back() T {
+	s.checkNotEmpty()
+	return s.l.RBegin().Read()
+}
+
+func (s ListStorage[T]) Front() T {
+	s.checkNotEmpty()
+	return s.l.Begin().Read()
+}
+
+func (s ListStorage[T]) checkNotEmpty() {
+	if s.l.Begin().Eq(s.l.End()) {
+		panic("adapter: empty list")
+	}
+}
+
+func (s ListStorage[T]) Len() int {
+	return s.l.Len()
+}
@@ -132,6 +132,99 @@ func FindEndBy[T1, T2 any, It1 ForwardReader[T1, It1], It2 ForwardReader[T2, It2
 	return result
 }
 
+// FindLast returns the last element in the range [first, last) that is equal
+// to value, or last if there is no such element.
+func FindLast[T comparable, It ForwardReader[T, It]](first, last It, v T) It {
+	return FindLastIf(first, last, __eq1(v))
+}
+
+// FindLastIf returns the last element in the range [first, last) which
+// predicate pred returns true, or last if there is no such element.
+//
+// Bidirectional ranges are searched backward from last.
+func FindLastIf[T any, It ForwardReader[T, It]](first, last It, pred UnaryPredicate[T]) It {
+	if _, ok := any(last).(BackwardMovable[It]); ok {
+		for it := last; !__iter_eq(it, first); {
+			if it = any(it).(BackwardMovable[It]).Prev(); pred(it.Read()) {
+				return it
+			}
+		}
+		return last
+	}
+	result := last
+	for ; !__iter_eq(first, last); first = first.Next() {
+		if pred(first.Read()) {
+			result = first
+		}
+	}
+	return result
+}
+
+// FindLastIfNot returns the last element in the range [first, last) which
+// predicate pred returns false, or last if there is no such element.
+func FindLastIfNot[T any, It ForwardReader[T, It]](first, last It, pred UnaryPredicate[T]) It {
+	return FindLastIf(first, last, __not1(pred))
+}
+
+// Contains checks if the range [first, last) contains an element equal to v.
+func Contains[T comparable, It InputIter[T, It]](first, last It, v T) bool {
+	return ContainsBy(first, last, v, __eq2[T])
+}
+
+// ContainsBy checks if the range [first, last) contains an element equal to v.
+//
+// Elements are compared using the given binary comparer eq.
+func ContainsBy[T1, T2 any, It InputIter[T1, It]](first, last It, v T2, eq EqComparer[T1, T2]) bool {
+	return AnyOf(first, last, __eq_bind2(eq, v))
+}
+
+// ContainsSubrange checks if the sequence [sFirst, sLast) occurs in the range
+// [first, last). An empty sequence occurs in any range.
+func ContainsSubrange[T comparable, It1 ForwardReader[T, It1], It2 ForwardReader[T, It2]](first, last It1, sFirst, sLast It2) bool {
+	return ContainsSubrangeBy(first, last, sFirst, sLast, __eq2[T])
+}
+
+// ContainsSubrangeBy checks if the sequence [sFirst, sLast) occurs in the
+// range [first, last). An empty sequence occurs in any range.
+//
+// Elements are compared using the given binary comparer eq.
+func ContainsSubrangeBy[T1, T2 any, It1 ForwardReader[T1, It1], It2 ForwardReader[T2, It2]](first, last It1, sFirst, sLast It2, eq EqComparer[T1, T2]) bool {
+	return __iter_eq(sFirst, sLast) || !__iter_eq(SearchBy(first, last, sFirst, sLast, eq), last)
+}
+
+// StartsWith checks if the range [first1, last1) starts with the range
+// [first2, last2).
+func StartsWith[T comparable, It1 InputIter[T, It1], It2 InputIter[T, It2]](first1, last1 It1, first2, last2 It2) bool {
+	return StartsWithBy(first1, last1, first2, last2, __eq2[T])
+}
+
+// StartsWithBy checks if the range [first1, last1) starts with the range
+// [first2, last2).
+//
+// Elements are compared using the given binary comparer eq.
+func StartsWithBy[T1, T2 any, It1 InputIter[T1, It1], It2 InputIter[T2, It2]](first1, last1 It1, first2, last2 It2, eq EqComparer[T1, T2]) bool {
+	_, it2 := MismatchBy(first1, last1, first2, &last2, eq)
+	return __iter_eq(it2, last2)
+}
+
+// EndsWith checks if the range [first1, last1) ends with the range
+// [first2, last2).
+func EndsWith[T comparable, It1 ForwardReader[T, It1], It2 ForwardReader[T, It2]](first1, last1 It1, first2, last2 It2) bool {
+	return EndsWithBy(first1, last1, first2, last2, __eq2[T])
+}
+
+// EndsWithBy checks if the range [first1, last1) ends with the range
+// [first2, last2).
+//
+// Elements are compared using the given binary comparer eq.
+func EndsWithBy[T1, T2 any, It1 ForwardReader[T1, It1], It2 ForwardReader[T2, It2]](first1, last1 It1, first2, last2 It2, eq EqComparer[T1, T2]) bool {
+	n1, n2 := Distance[T1](first1, last1), Distance[T2](first2, last2)
+	if n1 < n2 {
+		return false
+	}
+	return EqualBy(AdvanceN[T1](first1, n1-n2), last1, first2, &last2, eq)
+}
+
 // FindFirstOf searches the range [first, last) for any of the elements in the
 // range [sFirst, sLast).
 func FindFirstOf[T comparable, It ForwardReader[T, It]](first, last It, sFirst, sLast It) It {
@@ -490,6 +583,58 @@ func RotateCopy[T any, In ForwardReader[T, In], Out OutputIter[T]](first, nFirst
 	return Copy[T](first, nFirst, Copy[T](nFirst, last, dFirst))
 }
 
+// ShiftLeft shifts the elements in the range [first, last) by n positions
+// towards the beginning, and returns the end of the resulting range. The
+// elements in the vacated region are left in a valid but unspecified state. If
+// n <= 0, it does nothing and returns last. If n >= last - first, it does
+// nothing and returns first.
+func ShiftLeft[T any, It ForwardReadWriter[T, It]](first, last It, n int) It {
+	if n <= 0 {
+		return last
+	}
+	mid := first
+	for ; n > 0; n-- {
+		if __iter_eq(mid, last) {
+			return first
+		}
+		mid = mid.Next()
+	}
+	return Copy[T](mid, last, first)
+}
+
+// ShiftRight shifts the elements in the range [first, last) by n positions
+// towards the end, and returns the beginning of the resulting range. The
+// elements in the vacated region are left in a valid but unspecified state. If
+// n <= 0, it does nothing and returns first. If n >= last - first, it does
+// nothing and returns last.
+//
+// Bidirectional iterators are moved backward from last; forward ones rotate
+// the range, which swaps instead of assigning.
+func ShiftRight[T any, It ForwardReadWriter[T, It]](first, last It, n int) It {
+	if n <= 0 {
+		return first
+	}
+	if _, ok := any(first).(BackwardMovable[It]); ok {
+		mid := last
+		for i := 0; i < n; i++ {
+			if __iter_eq(mid, first) {
+				return last
+			}
+			mid = any(mid).(BackwardMovable[It]).Prev()
+		}
+		for !__iter_eq(first, mid) {
+			mid, last = any(mid).(BackwardMovable[It]).Prev(), any(last).(BackwardMovable[It]).Prev()
+			last.Write(mid.Read())
+		}
+		return last
+	}
+	d := Distance[T](first, last)
+	if n >= d {
+		return last
+	}
+	return Rotate[T](first, AdvanceN[T](first, d-n), last)
+}
+
 // Shuffle reorders the elements in the given range [first, last) such that each
 // possible permutation of those elements has equal probability of appearance.
 func Shuffle[T any, It RandomReadWriter[T, It]](first, last It, r *rand.Rand) {
@@ -2004,6 +2149,153 @@ func PrevPermutationBy[T any, It BidiReadWriter[T, It]](first, last It, less Les
 	}
 }
 
+// NthPermutation transforms the sorted range [first, last) into its n'th
+// permutation in lexicographic order, counting from 0, which is the result of
+// calling NextPermutation n times. The elements should be distinct, and n is
+// taken modulo the number of permutations. n must not be negative.
+func NthPermutation[T any, It ForwardReadWriter[T, It]](first, last It, n int) {
+	m := Distance[T](first, last)
+	// f[i] is i!, or n+1 if i! > n.
+	f := make([]int, m+1)
+	f[0] = 1
+	for i := 1; i <= m; i++ {
+		if f[i-1] > n/i {
+			f[i] = n + 1
+		} else {
+			f[i] = f[i-1] * i
+		}
+	}
+	n %= f[m]
+	for ; m > 1; first, m = first.Next(), m-1 {
+		if q := n / f[m-1]; q > 0 {
+			it := AdvanceN[T](first, q)
+			Rotate[T](first, it, it.Next())
+		}
+		n %= f[m-1]
+	}
+}
+
+// PermutationRank returns the position of the permutation in the range [first,
+// last) among all permutations of its elements in lexicographic order,
+// counting from 0. The elements should be distinct. The result overflows if
+// there are more than 20 elements.
+func PermutationRank[T Ordered, It ForwardReader[T, It]](first, last It) int {
+	return PermutationRankBy(first, last, __less[T])
+}
+
+// PermutationRankBy returns the position of the permutation in the range
+// [first, last) among all permutations of its elements in lexicographic order
+// with respect to less, counting from 0. The elements should be distinct. The
+// result overflows if there are more than 20 elements.
+//
+// Elements are compared using the given binary comparer less.
+func PermutationRankBy[T any, It ForwardReader[T, It]](first, last It, less LessComparer[T]) int {
+	var rank int
+	for m := Distance[T](first, last); m > 0; first, m = first.Next(), m-1 {
+		v := first.Read()
+		rank = rank*m + CountIf(first.Next(), last, func(x T) bool { return less(x, v) })
+	}
+	return rank
+}
+
+// NextCombination transforms the range [first, middle) into the next
+// combination of middle - first elements of the range [first, last) in
+// lexicographic order, and keeps the rest of the elements in [middle, last).
+// Both [first, middle) and [middle, last) must be sorted, which is the case if
+// [first, last) is sorted initially. Returns true if such combination exists,
+// otherwise transforms the range into the first combination (as if by
+// Sort(first, last)) and returns false.
+func NextCombination[T Ordered, It BidiReadWriter[T, It]](first, middle, last It) bool {
+	return NextCombinationBy(first, middle, last, __less[T])
+}
+
+// NextCombinationBy transforms the range [first, middle) into the next
+// combination of middle - first elements of the range [first, last) in
+// lexicographic order with respect to less, and keeps the rest of the elements
+// in [middle, last). Both [first, middle) and [middle, last) must be sorted,
+// which is the case if [first, last) is sorted initially. Returns true if such
+// combination exists, otherwise transforms the range into the first
+// combination (as if by SortBy(first, last, less)) and returns false.
+//
+// Elements are compared using the given binary comparer less.
+func NextCombinationBy[T any, It BidiReadWriter[T, It]](first, middle, last It, less LessComparer[T]) bool {
+	if __iter_eq(first, middle) || __iter_eq(middle, last) {
+		return false
+	}
+	back := last.Prev()
+	for i := middle; !__iter_eq(i, first); {
+		i = i.Prev()
+		if !less(i.Read(), back.Read()) {
+			continue
+		}
+		// Replace i with the smallest greater element j of the rest, then
+		// refill (i, middle) with the elements following j.
+		j := middle
+		for !less(i.Read(), j.Read()) {
+			j = j.Next()
+		}
+		Swap[T](i, j)
+		i, j = i.Next(), j.Next()
+		Rotate[T](i, j, last)
+		k := middle
+		for ; !__iter_eq(j, last); j = j.Next() {
+			k = k.Next()
+		}
+		Rotate[T](middle, k, last)
+		return true
+	}
+	Rotate[T](first, middle, last)
+	return false
+}
+
+// NextKPermutation transforms the range [first, middle) into the next
+// permutation of middle - first elements of the range [first, last) in
+// lexicographic order, and keeps the rest of the elements sorted in [middle,
+// last). [middle, last) must be sorted, which is the case if [first, last) is
+// sorted initially. Returns true if such permutation exists, otherwise
+// transforms the range into the first one (as if by Sort(first, last)) and
+// returns false.
+func NextKPermutation[T Ordered, It BidiReadWriter[T, It]](first, middle, last It) bool {
+	return NextKPermutationBy(first, middle, last, __less[T])
+}
+
+// NextKPermutationBy transforms the range [first, middle) into the next
+// permutation of middle - first elements of the range [first, last) in
+// lexicographic order with respect to less, and keeps the rest of the elements
+// sorted in [middle, last). [middle, last) must be sorted, which is the case
+// if [first, last) is sorted initially. Returns true if such permutation
+// exists, otherwise transforms the range into the first one (as if by
+// SortBy(first, last, less)) and returns false.
+//
+// Elements are compared using the given binary comparer less.
+func NextKPermutationBy[T any, It BidiReadWriter[T, It]](first, middle, last It, less LessComparer[T]) bool {
+	Reverse[T](middle, last)
+	return NextPermutationBy(first, last, less)
+}
+
+// NextGraySubset transforms the subset marked by the flags in the range [first,
+// last) into the next subset in binary reflected Gray code order, where the
+// first flag is the least significant bit. Exactly one flag is flipped.
+// Returns true if such subset exists, otherwise transforms the range into the
+// empty subset (all false) and returns false.
+func NextGraySubset[It ForwardReadWriter[bool, It]](first, last It) bool {
+	if __iter_eq(first, last) {
+		return false
+	}
+	it := first
+	if Count(first, last, true)%2 == 1 {
+		// Flip the flag after the first set one, or the last flag itself.
+		it = Find(first, last, true)
+		if __iter_eq(it.Next(), last) {
+			it.Write(false)
+			return false
+		}
+		it = it.Next()
+	}
+	it.Write(!it.Read())
+	return true
+}
+
 // Iota fills the range [first, last) with sequentially increasing values,
 // starting with v and repetitively evaluating v++.
 func Iota[T Integer, It ForwardWriter[T, It]](first, last It, v T) {
@@ -2049,6 +2341,99 @@ func InnerProductBy[T1, T2, T3, T4 any, It1 InputIter[T1, It1], It2 InputIter[T2
 	return v
 }
 
+// Reduce computes the sum of the given value v and the elements in the range
+// [first, last). Unlike Accumulate, the elements may be added in any order and
+// grouping.
+func Reduce[T Numeric, It InputIter[T, It]](first, last It, v T) T {
+	return ReduceBy(first, last, v, __add[T, T])
+}
+
+// ReduceBy reduces the given value v and the elements in the range
+// [first, last) with op. Unlike AccumulateBy, op may be applied in any order
+// and grouping, so the result is nondeterministic if op is not associative
+// and commutative.
+func ReduceBy[T any, It InputIter[T, It]](first, last It, v T, op BinaryOperation[T, T, T]) T {
+	return TransformReduceBy(first, last, v, op, __noop[T])
+}
+
+// TransformReduce applies op to each element in the range [first, last), and
+// computes the sum of v and the results, in any order and grouping.
+func TransformReduce[T1 any, T2 Numeric, It InputIter[T1, It]](first, last It, v T2, op UnaryOperation[T1, T2]) T2 {
+	return TransformReduceBy(first, last, v, __add[T2, T2], op)
+}
+
+// TransformReduceBy applies transform to each element in the range
+// [first, last), and reduces v and the results with reduce. reduce may be
+// applied in any order and grouping, so the result is nondeterministic if
+// reduce is not associative and commutative.
+func TransformReduceBy[T1, T2 any, It InputIter[T1, It]](first, last It, v T2, reduce BinaryOperation[T2, T2, T2], transform UnaryOperation[T1, T2]) T2 {
+	for ; !__iter_eq(first, last); first = first.Next() {
+		v = reduce(v, transform(first.Read()))
+	}
+	return v
+}
+
+// TransformReduceBinary computes the sum of v and the products of the pairs of
+// elements in the range [first1, last1) and the range beginning at first2.
+// Unlike InnerProduct, the products may be added in any order and grouping.
+func TransformReduceBinary[T Numeric, It1 InputIter[T, It1], It2 InputIter[T, It2]](first1, last1 It1, first2 It2, v T) T {
+	return TransformReduceBinaryBy(first1, last1, first2, v, __add[T, T], __mul[T, T])
+}
+
+// TransformReduceBinaryBy applies transform to the pairs of elements in the
+// range [first1, last1) and the range beginning at first2, and reduces v and
+// the results with reduce. reduce may be applied in any order and grouping,
+// so the result is nondeterministic if reduce is not associative and
+// commutative.
+func TransformReduceBinaryBy[T1, T2, T3 any, It1 InputIter[T1, It1], It2 InputIter[T2, It2]](first1, last1 It1, first2 It2, v T3, reduce BinaryOperation[T3, T3, T3], transform BinaryOperation[T1, T2, T3]) T3 {
+	for ; !__iter_eq(first1, last1); first1, first2 = first1.Next(), first2.Next() {
+		v = reduce(v, transform(first1.Read(), first2.Read()))
+	}
+	return v
+}
+
+// FoldLeft folds the elements in the range [first, last) from the left, using
+// v=f(v,x), and returns v.
+func FoldLeft[T1, T2 any, It InputIter[T1, It]](first, last It, v T2, f BinaryOperation[T2, T1, T2]) T2 {
+	for ; !__iter_eq(first, last); first = first.Next() {
+		v = f(v, first.Read())
+	}
+	return v
+}
+
+// FoldLeftFirst folds the elements in the range [first, last) from the left,
+// using the first element as the initial value. It returns false if the range
+// is empty.
+func FoldLeftFirst[T any, It InputIter[T, It]](first, last It, f BinaryOperation[T, T, T]) (T, bool) {
+	if __iter_eq(first, last) {
+		var zero T
+		return zero, false
+	}
+	return FoldLeft(first.Next(), last, first.Read(), f), true
+}
+
+// FoldRight folds the elements in the range [first, last) from the right,
+// using v=f(x,v), and returns v.
+func FoldRight[T1, T2 any, It BidiReader[T1, It]](first, last It, v T2, f BinaryOperation[T1, T2, T2]) T2 {
+	for !__iter_eq(first, last) {
+		last = last.Prev()
+		v = f(last.Read(), v)
+	}
+	return v
+}
+
+// FoldRightLast folds the elements in the range [first, last) from the right,
+// using the last element as the initial value. It returns false if the range
+// is empty.
+func FoldRightLast[T any, It BidiReader[T, It]](first, last It, f BinaryOperation[T, T, T]) (T, bool) {
+	if __iter_eq(first, last) {
+		var zero T
+		return zero, false
+	}
+	last = last.Prev()
+	return FoldRight(first, last, last.Read(), f), true
+}
+
 // AdjacentDifference computes the differences between the second and the first
 // of each adjacent pair of elements of the range [first, last) and writes them
 // to the range beginning at dFirst + 1. An unmodified copy of first is
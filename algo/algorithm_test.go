@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -208,6 +209,56 @@ func TestFindEnd(t *testing.T) {
 	assert.True(__eq(it, _last_int(a)))
 }
 
+func TestFindLast(t *testing.T) {
+	assert := assert.New(t)
+	a := []int{1, 2, 3, 2, 1}
+	assert.Equal(3, _first_int(a).Distance(FindLast(_first_int(a), _last_int(a), 2)))
+	assert.True(FindLast(_first_int(a), _last_int(a), 4).Eq(_last_int(a)))
+	assert.Equal(2, _first_int(a).Distance(FindLastIf(_first_int(a), _last_int(a), func(x int) bool { return x > 2 })))
+	assert.Equal(4, _first_int(a).Distance(FindLastIfNot(_first_int(a), _last_int(a), func(x int) bool { return x > 1 })))
+	assert.Equal(3, _first_int_r(a).Distance(FindLast(_first_int_r(a), _last_int_r(a), 2)))
+
+	l := list.New()
+	Copy[int](_first_int(a), _last_int(a), lists.ListBackInserter[int](l))
+	it := FindLast(forwardListBegin[int](l), &forwardListIter[int]{l: l}, 2)
+	assert.Equal(l.Back().Prev(), it.e)
+	assert.True(FindLast(forwardListBegin[int](l), &forwardListIter[int]{l: l}, 4).Eq(&forwardListIter[int]{l: l}))
+	assert.True(FindLast(_head_int(l), _tail_int(l), 2).Eq(_tail_int(l).Prev().Prev()))
+}
+
+func TestContainsStartsEnds(t *testing.T) {
+	assert := assert.New(t)
+	s := "hello world"
+	sub := func(x string) (strs.Iterator, strs.Iterator) { return _first_str(x), _last_str(x) }
+	f, l := sub(s)
+	assert.True(Contains(f, l, byte('w')))
+	assert.False(Contains(f, l, byte('z')))
+	assert.True(ContainsBy(f, l, 'W', func(x byte, y rune) bool { return x|0x20 == byte(y)|0x20 }))
+
+	for _, c := range []struct {
+		x                        string
+		contains, prefix, suffix bool
+	}{
+		{"", true, true, true},
+		{"hello", true, true, false},
+		{"world", true, false, true},
+		{"o w", true, false, false},
+		{"hello world!", false, false, false},
+		{"d", true, false, true},
+	} {
+		f2, l2 := sub(c.x)
+		assert.Equal(c.contains, ContainsSubrange[byte](f, l, f2, l2), c.x)
+		assert.Equal(c.prefix, StartsWith[byte](f, l, f2, l2), c.x)
+		assert.Equal(c.suffix, EndsWith[byte](f, l, f2, l2), c.x)
+	}
+
+	eq := func(x int, y string) bool { return strconv.Itoa(x) == y }
+	a, b := []int{1, 2, 3}, []string{"2", "3"}
+	assert.True(ContainsSubrangeBy(_first_int(a), _last_int(a), slices.Begin(b), slices.End(b), eq))
+	assert.False(StartsWithBy(_first_int(a), _last_int(a), slices.Begin(b), slices.End(b), eq))
+	assert.True(EndsWithBy(_first_int(a), _last_int(a), slices.Begin(b), slices.End(b), eq))
+}
+
 func TestFindFirstOf(t *testing.T) {
 	a, b := randString(), randString()
 	i := strings.IndexAny(a, b)
@@ -486,6 +537,47 @@ func TestRotate(t *testing.T) {
 	sliceEqual(assert, d, c)
 }
 
+func TestShift(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{-1, 0, 1, 3, 7, 8, 10} {
+		a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		wantLeft, wantRight := a, a
+		if n > 0 && n < len(a) {
+			wantLeft = append(a[n:len(a):len(a)], a[len(a)-n:]...)
+			wantRight = append(a[:n:n], a[:len(a)-n]...)
+		}
+		l, rl := len(a)-n, n
+		if n <= 0 {
+			l, rl = len(a), 0
+		} else if n >= len(a) {
+			l, rl = 0, len(a)
+		}
+
+		b := append([]int{}, a...)
+		assert.Equal(l, slices.Begin(b).Distance(ShiftLeft[int](slices.Begin(b), slices.End(b), n)))
+		assert.Equal(wantLeft, b)
+
+		b = append([]int{}, a...)
+		assert.Equal(rl, slices.Begin(b).Distance(ShiftRight[int](slices.Begin(b), slices.End(b), n)))
+		assert.Equal(wantRight[rl:], b[rl:])
+
+		lst := list.New()
+		Copy[int](slices.Begin(a), slices.End(a), lists.ListBackInserter[int](lst))
+		ShiftLeft[int](forwardListBegin[int](lst), &forwardListIter[int]{l: lst}, n)
+		var c []int
+		Copy[int](lists.Begin[int](lst), lists.End[int](lst), slices.Appender(&c))
+		assert.Equal(wantLeft, c)
+
+		lst = list.New()
+		Copy[int](slices.Begin(a), slices.End(a), lists.ListBackInserter[int](lst))
+		first := ShiftRight[int](forwardListBegin[int](lst), &forwardListIter[int]{l: lst}, n)
+		c = nil
+		Copy[int](first, &forwardListIter[int]{l: lst}, slices.Appender(&c))
+		assert.Equal(len(a)-rl, len(c))
+		assert.Equal(wantRight[rl:], append([]int{}, c...))
+	}
+}
+
 func TestShuffle(t *testing.T) {
 	assert := assert.New(t)
 	a := randIntSlice()
@@ -1095,6 +1187,133 @@ func TestPermutation(t *testing.T) {
 	}
 }
 
+func TestNthPermutation(t *testing.T) {
+	assert := assert.New(t)
+	for n := 0; n <= 5; n++ {
+		a := make([]int, n)
+		Iota(_first_int(a), _last_int(a), 0)
+		for i := 0; ; i++ {
+			b := make([]int, n)
+			Iota(_first_int(b), _last_int(b), 0)
+			NthPermutation[int](_first_int(b), _last_int(b), i)
+			assert.Equal(a, b)
+			assert.Equal(i, PermutationRank[int](_first_int(a), _last_int(a)))
+			if !NextPermutation[int](_first_int(a), _last_int(a)) {
+				Sort[int](_first_int(b), _last_int(b))
+				NthPermutation[int](_first_int(b), _last_int(b), i+1)
+				assert.Equal(a, b)
+				break
+			}
+		}
+	}
+	a := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v"}
+	NthPermutation[string](slices.Begin(a), slices.End(a), 1<<62)
+	assert.Equal(1<<62, PermutationRankBy(slices.Begin(a), slices.End(a), func(x, y string) bool { return x < y }))
+}
+
+func TestNextCombination(t *testing.T) {
+	assert := assert.New(t)
+	for n := 0; n <= 6; n++ {
+		for k := 0; k <= n; k++ {
+			var want [][]int
+			for mask := 0; mask < 1<<n; mask++ {
+				var c []int
+				for i := 0; i < n; i++ {
+					if mask&(1<<i) != 0 {
+						c = append(c, i)
+					}
+				}
+				if len(c) == k {
+					want = append(want, c)
+				}
+			}
+			sort.Slice(want, func(i, j int) bool {
+				return LexicographicalCompare[int](_first_int(want[i]), _last_int(want[i]), _first_int(want[j]), _last_int(want[j]))
+			})
+			l := lists.NewList[int]()
+			ids := make([]int, n)
+			Iota(_first_int(ids), _last_int(ids), 0)
+			for _, v := range ids {
+				l.PushBack(v)
+			}
+			var got [][]int
+			for {
+				var c []int
+				Copy[int](l.Begin(), AdvanceN[int](l.Begin(), k), slices.Appender(&c))
+				got = append(got, append([]int{}, c...))
+				var rest []int
+				Copy[int](AdvanceN[int](l.Begin(), k), l.End(), slices.Appender(&rest))
+				assert.True(IsSorted[int](_first_int(rest), _last_int(rest)))
+				if !NextCombination[int](l.Begin(), AdvanceN[int](l.Begin(), k), l.End()) {
+					break
+				}
+			}
+			assert.Equal(len(want), len(got))
+			for i := range want {
+				sliceEqual(assert, want[i], got[i])
+			}
+			var all []int
+			Copy[int](l.Begin(), l.End(), slices.Appender(&all))
+			sliceEqual(assert, ids, all)
+		}
+	}
+
+	// duplicates are enumerated once
+	a := []int{1, 1, 2, 2, 3}
+	var count int
+	for ok := true; ok; ok = NextCombination[int](_first_int(a), _first_int(a).AdvanceN(2), _last_int(a)) {
+		count++
+	}
+	assert.Equal(5, count) // 11 12 13 22 23
+}
+
+func TestNextKPermutation(t *testing.T) {
+	assert := assert.New(t)
+	a := []int{1, 2, 3, 4, 5}
+	prev := []int{}
+	var count int
+	for ok := true; ok; ok = NextKPermutation[int](_first_int(a), _first_int(a).AdvanceN(3), _last_int(a)) {
+		cur := append([]int{}, a[:3]...)
+		assert.True(LexicographicalCompare[int](_first_int(prev), _last_int(prev), _first_int(cur), _last_int(cur)))
+		assert.True(IsSorted[int](_first_int(a[3:]), _last_int(a[3:])))
+		prev = cur
+		count++
+	}
+	assert.Equal(60, count)
+	assert.Equal([]int{1, 2, 3, 4, 5}, a)
+}
+
+func TestNextGraySubset(t *testing.T) {
+	assert := assert.New(t)
+	for n := 0; n <= 6; n++ {
+		a := make([]bool, n)
+		seen := make(map[int]bool)
+		code := func() int {
+			var x int
+			for i := n - 1; i >= 0; i-- {
+				x <<= 1
+				if a[i] {
+					x |= 1
+				}
+			}
+			return x
+		}
+		for i := 0; ; i++ {
+			assert.Equal(i^(i>>1), code())
+			seen[code()] = true
+			prev := code()
+			ok := NextGraySubset(_first_bool(a), _last_bool(a))
+			diff := prev ^ code()
+			assert.True(n == 0 || diff != 0 && diff&(diff-1) == 0)
+			if !ok {
+				assert.Equal(0, code())
+				break
+			}
+		}
+		assert.Equal(1<<n, len(seen))
+	}
+}
+
 func TestIota(t *testing.T) {
 	l := randInt()
 	a := make([]int, l)
@@ -1126,6 +1345,58 @@ func TestInnerProduct(t *testing.T) {
 	assert.New(t).Equal(p, p2)
 }
 
+func TestReduce(t *testing.T) {
+	assert := assert.New(t)
+	a, b := randIntSlice(), randIntSlice()
+	assert.Equal(Accumulate(_first_int(a), _last_int(a), 1), Reduce(_first_int(a), _last_int(a), 1))
+	maxOp := func(x, y int) int { return Max(x, y) }
+	assert.Equal(-1, ReduceBy(_first_int(a[:0]), _last_int(a[:0]), -1, maxOp))
+	if len(a) > 0 {
+		assert.Equal(MaxElement[int](_first_int(a), _last_int(a)).Read(), ReduceBy(_first_int(a), _last_int(a), -1, maxOp))
+	}
+
+	square := func(x int) int { return x * x }
+	assert.Equal(InnerProduct(_first_int(a), _last_int(a), _first_int(a), 0), TransformReduce(_first_int(a), _last_int(a), 0, square))
+	count := TransformReduceBy(_first_str("hello"), _last_str("hello"), 0, func(x, y int) int { return x + y }, func(c byte) int {
+		if c == 'l' {
+			return 1
+		}
+		return 0
+	})
+	assert.Equal(2, count)
+
+	l := Min(len(a), len(b))
+	assert.Equal(InnerProduct(_first_int(a), _last_int(a[:l]), _first_int(b), 3), TransformReduceBinary(_first_int(a), _last_int(a[:l]), _first_int(b), 3))
+	assert.Equal(l, TransformReduceBinaryBy(_first_int(a), _last_int(a[:l]), _first_int(b), 0, func(x, y int) int { return x + y }, func(x, y int) int { return 1 }))
+}
+
+func TestFold(t *testing.T) {
+	assert := assert.New(t)
+	s := "abcd"
+	cat := func(x, y string) string { return "(" + x + y + ")" }
+	left := func(v string, c byte) string { return cat(v, string(c)) }
+	right := func(c byte, v string) string { return cat(string(c), v) }
+	assert.Equal("((((_a)b)c)d)", FoldLeft(_first_str(s), _last_str(s), "_", left))
+	assert.Equal("(a(b(c(d_))))", FoldRight(_first_str(s), _last_str(s), "_", right))
+	assert.Equal("_", FoldLeft(_first_str(""), _last_str(""), "_", left))
+	assert.Equal("(d(c(b(a_))))", FoldRight(_first_str_r(s), _last_str_r(s), "_", right))
+
+	a := []int{1, 2, 3, 4}
+	sub := func(x, y int) int { return x - y }
+	v, ok := FoldLeftFirst(_first_int(a), _last_int(a), sub)
+	assert.True(ok)
+	assert.Equal(((1-2)-3)-4, v)
+	v, ok = FoldRightLast(_first_int(a), _last_int(a), sub)
+	assert.True(ok)
+	assert.Equal(1-(2-(3-4)), v)
+	_, ok = FoldLeftFirst(_first_int(a[:0]), _last_int(a[:0]), sub)
+	assert.False(ok)
+	_, ok = FoldRightLast(_first_int(a[:0]), _last_int(a[:0]), sub)
+	assert.False(ok)
+	v, ok = FoldRightLast(_first_int(a[:1]), _last_int(a[:1]), sub)
+	assert.Equal(1, v)
+}
+
 func TestPartialSum(t *testing.T) {
 	assert := assert.New(t)
 	a := randIntSlice()
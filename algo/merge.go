@@ -0,0 +1,186 @@
+package algo
+
+import (
+	"container/heap"
+
+	. "github.com/disksing/iter/v2"
+)
+
+// mergeHeap is a min-heap of the heads of the input ranges. Of equivalent
+// heads, the one of the lower input is the smaller, so merges are stable.
+type mergeHeap[T any, It InputIter[T, It]] struct {
+	firsts, lasts []It
+	heads         []T
+	srcs          []int // inputs with elements left, ordered as a heap
+	less          LessComparer[T]
+}
+
+func (h *mergeHeap[T, It]) Len() int { return len(h.srcs) }
+
+func (h *mergeHeap[T, It]) Less(i, j int) bool {
+	x, y := h.srcs[i], h.srcs[j]
+	if h.less(h.heads[x], h.heads[y]) {
+		return true
+	}
+	return !h.less(h.heads[y], h.heads[x]) && x < y
+}
+
+func (h *mergeHeap[T, It]) Swap(i, j int) { h.srcs[i], h.srcs[j] = h.srcs[j], h.srcs[i] }
+
+func (h *mergeHeap[T, It]) Push(x any) { h.srcs = append(h.srcs, x.(int)) }
+
+func (h *mergeHeap[T, It]) Pop() any {
+	x := h.srcs[len(h.srcs)-1]
+	h.srcs = h.srcs[:len(h.srcs)-1]
+	return x
+}
+
+// MergeKIterator is an input iterator that merges several sorted ranges
+// lazily. Each step takes O(log k) time for k ranges. A nil *MergeKIterator is
+// its end sentinel.
+type MergeKIterator[T any, It InputIter[T, It]] struct {
+	h *mergeHeap[T, It]
+}
+
+// MergeKBegin returns an iterator to the first element of the merge of the
+// sorted ranges [firsts[i], lasts[i]). For equivalent elements, the ones from
+// a range with a lower index precede the ones from the others. The range
+// iterators are advanced as the merge proceeds.
+func MergeKBegin[T Ordered, It InputIter[T, It]](firsts, lasts []It) *MergeKIterator[T, It] {
+	return MergeKBeginBy(firsts, lasts, __less[T])
+}
+
+// MergeKBeginBy returns an iterator to the first element of the merge of the
+// sorted ranges [firsts[i], lasts[i]). For equivalent elements, the ones from
+// a range with a lower index precede the ones from the others. The range
+// iterators are advanced as the merge proceeds.
+//
+// Elements are compared using the given binary comparer less.
+func MergeKBeginBy[T any, It InputIter[T, It]](firsts, lasts []It, less LessComparer[T]) *MergeKIterator[T, It] {
+	h := &mergeHeap[T, It]{
+		firsts: append([]It(nil), firsts...),
+		lasts:  lasts,
+		heads:  make([]T, len(firsts)),
+		less:   less,
+	}
+	for i, first := range h.firsts {
+		if !__iter_eq(first, lasts[i]) {
+			h.heads[i] = first.Read()
+			h.srcs = append(h.srcs, i)
+		}
+	}
+	heap.Init(h)
+	return &MergeKIterator[T, It]{h: h}
+}
+
+// MergeKEnd returns an iterator to the passed last element of any merge.
+func MergeKEnd[T any, It InputIter[T, It]]() *MergeKIterator[T, It] {
+	return nil
+}
+
+func (it *MergeKIterator[T, It]) atEnd() bool {
+	return it == nil || len(it.h.srcs) == 0
+}
+
+func (it *MergeKIterator[T, It]) Eq(x *MergeKIterator[T, It]) bool {
+	if e1, e2 := it.atEnd(), x.atEnd(); e1 || e2 {
+		return e1 && e2
+	}
+	return it == x
+}
+
+func (it *MergeKIterator[T, It]) Read() T {
+	return it.h.heads[it.h.srcs[0]]
+}
+
+// Source returns the index of the range that the current element comes from.
+func (it *MergeKIterator[T, It]) Source() int {
+	return it.h.srcs[0]
+}
+
+func (it *MergeKIterator[T, It]) Next() *MergeKIterator[T, It] {
+	h := it.h
+	i := h.srcs[0]
+	if h.firsts[i] = h.firsts[i].Next(); __iter_eq(h.firsts[i], h.lasts[i]) {
+		heap.Pop(h)
+	} else {
+		h.heads[i] = h.firsts[i].Read()
+		heap.Fix(h, 0)
+	}
+	return it
+}
+
+// MergeK merges the sorted ranges [firsts[i], lasts[i]) into one sorted range
+// beginning at dFirst, in O(n log k) time for n elements of k ranges. For
+// equivalent elements, the ones from a range with a lower index precede the
+// ones from the others.
+func MergeK[T Ordered, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out) Out {
+	return MergeKBy(firsts, lasts, dFirst, __less[T])
+}
+
+// MergeKBy merges the sorted ranges [firsts[i], lasts[i]) into one sorted
+// range beginning at dFirst, in O(n log k) time for n elements of k ranges.
+// For equivalent elements, the ones from a range with a lower index precede
+// the ones from the others.
+//
+// Elements are compared using the given binary comparer less.
+func MergeKBy[T any, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out, less LessComparer[T]) Out {
+	return Copy[T](MergeKBeginBy(firsts, lasts, less), MergeKEnd[T, In](), dFirst)
+}
+
+// MergeKUnique merges the sorted ranges [firsts[i], lasts[i]) into one sorted
+// range beginning at dFirst, and copies only the first element of each group
+// of equal elements. The copied element comes from the range with the lowest
+// index.
+func MergeKUnique[T Ordered, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out) Out {
+	return MergeKUniqueBy(firsts, lasts, dFirst, __less[T])
+}
+
+// MergeKUniqueBy merges the sorted ranges [firsts[i], lasts[i]) into one
+// sorted range beginning at dFirst, and copies only the first element of each
+// group of equivalent elements. The copied element comes from the range with
+// the lowest index.
+//
+// Elements are compared using the given binary comparer less.
+func MergeKUniqueBy[T any, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out, less LessComparer[T]) Out {
+	eq := func(x, y T) bool { return !less(x, y) && !less(y, x) }
+	return UniqueCopyIf(MergeKBeginBy(firsts, lasts, less), MergeKEnd[T, In](), dFirst, eq)
+}
+
+// SetUnionK constructs a sorted range beginning at dFirst consisting of the
+// multiset union of the sorted ranges [firsts[i], lasts[i]).
+//
+// If some element is found m[i] times in the i'th range, then Max(m) elements
+// are copied to dFirst, preserving order: all m[0] elements from the first
+// range, then the last Max(m[1]-m[0], 0) elements from the second range, and
+// so on, as if by repeated SetUnion.
+func SetUnionK[T Ordered, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out) Out {
+	return SetUnionKBy(firsts, lasts, dFirst, __less[T])
+}
+
+// SetUnionKBy constructs a sorted range beginning at dFirst consisting of the
+// multiset union of the sorted ranges [firsts[i], lasts[i]).
+//
+// If some element is found m[i] times in the i'th range, then Max(m) elements
+// are copied to dFirst, preserving order: all m[0] elements from the first
+// range, then the last Max(m[1]-m[0], 0) elements from the second range, and
+// so on, as if by repeated SetUnionBy. Elements are compared using the given
+// binary comparer less.
+func SetUnionKBy[T any, In InputIter[T, In], Out OutputIter[T]](firsts, lasts []In, dFirst Out, less LessComparer[T]) Out {
+	it := MergeKBeginBy(firsts, lasts, less)
+	for !it.atEnd() {
+		v := it.Read()
+		// The group of elements equivalent to v is ordered by source.
+		var most, count int
+		for src := -1; !it.atEnd() && !less(v, it.Read()); it = it.Next() {
+			if it.Source() != src {
+				src, count = it.Source(), 0
+			}
+			if count++; count > most {
+				most = count
+				dFirst = __write_next(dFirst, it.Read())
+			}
+		}
+	}
+	return dFirst
+}
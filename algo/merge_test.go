@@ -0,0 +1,97 @@
+package algo_test
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/disksing/iter/v2"
+	. "github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+type mergeItem struct{ v, src, i int }
+
+func lessMergeItem(x, y mergeItem) bool { return x.v < y.v }
+
+func randSortedRanges() ([][]mergeItem, []slices.Iterator[mergeItem], []slices.Iterator[mergeItem]) {
+	var ranges [][]mergeItem
+	var firsts, lasts []slices.Iterator[mergeItem]
+	for src, k := 0, r.Intn(10); src < k; src++ {
+		var s []mergeItem
+		for i, n := 0, r.Intn(20); i < n; i++ {
+			s = append(s, mergeItem{r.Intn(10), src, 0})
+		}
+		sort.SliceStable(s, func(i, j int) bool { return s[i].v < s[j].v })
+		for i := range s {
+			s[i].i = i
+		}
+		ranges = append(ranges, s)
+		firsts, lasts = append(firsts, slices.Begin(s)), append(lasts, slices.End(s))
+	}
+	return ranges, firsts, lasts
+}
+
+func TestMergeK(t *testing.T) {
+	assert := assert.New(t)
+	for n := 0; n < 50; n++ {
+		ranges, firsts, lasts := randSortedRanges()
+		var want []mergeItem
+		for _, s := range ranges {
+			want = append(want, s...)
+		}
+		sort.SliceStable(want, func(i, j int) bool { return want[i].v < want[j].v })
+		var got []mergeItem
+		MergeKBy(firsts, lasts, slices.Appender(&got), lessMergeItem)
+		assert.Equal(len(want), len(got))
+		if len(want) > 0 {
+			assert.Equal(want, got)
+		}
+
+		var unique []mergeItem
+		MergeKUniqueBy(firsts, lasts, slices.Appender(&unique), lessMergeItem)
+		assert.Equal(slices.UniqueIf(want, func(x, y mergeItem) bool { return x.v == y.v }), unique)
+
+		var union []mergeItem
+		for _, s := range ranges {
+			var u []mergeItem
+			SetUnionBy(slices.Begin(union), slices.End(union), slices.Begin(s), slices.End(s), slices.Appender(&u), lessMergeItem)
+			union = u
+		}
+		var unionK []mergeItem
+		SetUnionKBy(firsts, lasts, slices.Appender(&unionK), lessMergeItem)
+		assert.Equal(union, unionK)
+	}
+}
+
+func TestMergeKIterator(t *testing.T) {
+	assert := assert.New(t)
+	chans := []chan int{make(chan int, 3), make(chan int, 3), make(chan int, 3)}
+	var firsts, lasts []*ChannelReader[int]
+	for i, values := range [][]int{{1, 4, 7}, {2, 5, 8}, {}} {
+		for _, v := range values {
+			chans[i] <- v
+		}
+		close(chans[i])
+		firsts, lasts = append(firsts, ChanReader(chans[i])), append(lasts, nil)
+	}
+	it := MergeKBegin(firsts, lasts)
+	var got, srcs []int
+	for ; !it.Eq(MergeKEnd[int, *ChannelReader[int]]()); it = it.Next() {
+		got, srcs = append(got, it.Read()), append(srcs, it.Source())
+	}
+	assert.Equal([]int{1, 2, 4, 5, 7, 8}, got)
+	assert.Equal([]int{0, 1, 0, 1, 0, 1}, srcs)
+	assert.True(it.Eq(nil))
+
+	a, b := []int{1, 1, 3}, []int{1, 2, 3, 3}
+	firsts2, lasts2 := []slices.Iterator[int]{slices.Begin(a), slices.Begin(b)}, []slices.Iterator[int]{slices.End(a), slices.End(b)}
+	var merged, unique, union []int
+	MergeK(firsts2, lasts2, slices.Appender(&merged))
+	MergeKUnique(firsts2, lasts2, slices.Appender(&unique))
+	SetUnionK(firsts2, lasts2, slices.Appender(&union))
+	assert.Equal([]int{1, 1, 1, 2, 3, 3, 3}, merged)
+	assert.Equal([]int{1, 2, 3}, unique)
+	assert.Equal([]int{1, 1, 2, 3, 3}, union)
+	assert.True(MergeKBegin[int, slices.Iterator[int]](nil, nil).Eq(nil))
+}
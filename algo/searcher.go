@@ -0,0 +1,251 @@
+package algo
+
+import (
+	. "github.com/disksing/iter/v2"
+)
+
+// Searcher searches ranges for a pattern which is prepared once on
+// construction, like the searchers of C++ std::search.
+//
+// Search returns the first occurrence [mFirst, mLast) of the pattern in the
+// range [first, last), or (last, last) if there is no such occurrence. An empty
+// pattern is found at first.
+type Searcher[It any] interface {
+	Search(first, last It) (It, It)
+}
+
+// SearchWith searches for the first occurrence of the pattern of s in the
+// range [first, last). It returns last if there is no such occurrence.
+func SearchWith[It any](first, last It, s Searcher[It]) It {
+	mFirst, _ := s.Search(first, last)
+	return mFirst
+}
+
+// DefaultSearcher is a Searcher using the same algorithm as SearchBy. It takes
+// O(n·m) time in the worst case and needs no preprocessing.
+type DefaultSearcher[T1, T2 any, It ForwardReader[T1, It]] struct {
+	pattern []T2
+	eq      EqComparer[T1, T2]
+}
+
+// NewDefaultSearcher returns a DefaultSearcher for the pattern [sFirst,
+// sLast). The pattern is copied.
+func NewDefaultSearcher[It ForwardReader[T, It], T comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt) *DefaultSearcher[T, T, It] {
+	return NewDefaultSearcherBy[It](sFirst, sLast, __eq2[T])
+}
+
+// NewDefaultSearcherBy returns a DefaultSearcher for the pattern [sFirst,
+// sLast). The pattern is copied.
+//
+// Elements are compared using the given binary comparer eq.
+func NewDefaultSearcherBy[It ForwardReader[T1, It], T1, T2 any, PIt ForwardReader[T2, PIt]](sFirst, sLast PIt, eq EqComparer[T1, T2]) *DefaultSearcher[T1, T2, It] {
+	return &DefaultSearcher[T1, T2, It]{pattern: __collect(sFirst, sLast), eq: eq}
+}
+
+func (s *DefaultSearcher[T1, T2, It]) Search(first, last It) (It, It) {
+	for {
+		it := first
+		for i := 0; ; i, it = i+1, it.Next() {
+			if i == len(s.pattern) {
+				return first, it
+			}
+			if __iter_eq(it, last) {
+				return last, last
+			}
+			if !s.eq(it.Read(), s.pattern[i]) {
+				break
+			}
+		}
+		first = first.Next()
+	}
+}
+
+// KMPSearcher is a Searcher using the Knuth-Morris-Pratt algorithm. It reads
+// each element of the searched range once and takes O(n+m) time.
+type KMPSearcher[T any, It ForwardReader[T, It]] struct {
+	pattern []T
+	fail    []int // fail[i] is the length of the longest proper border of pattern[:i+1]
+	eq      EqComparer[T, T]
+}
+
+// NewKMPSearcher returns a KMPSearcher for the pattern [sFirst, sLast). The
+// pattern is copied.
+func NewKMPSearcher[It ForwardReader[T, It], T comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt) *KMPSearcher[T, It] {
+	return NewKMPSearcherBy[It](sFirst, sLast, __eq2[T])
+}
+
+// NewKMPSearcherBy returns a KMPSearcher for the pattern [sFirst, sLast). The
+// pattern is copied.
+//
+// Elements are compared using the given binary comparer eq, which must be an
+// equivalence relation.
+func NewKMPSearcherBy[It ForwardReader[T, It], T any, PIt ForwardReader[T, PIt]](sFirst, sLast PIt, eq EqComparer[T, T]) *KMPSearcher[T, It] {
+	p := __collect(sFirst, sLast)
+	fail := make([]int, len(p))
+	for i, k := 1, 0; i < len(p); i++ {
+		for k > 0 && !eq(p[i], p[k]) {
+			k = fail[k-1]
+		}
+		if eq(p[i], p[k]) {
+			k++
+		}
+		fail[i] = k
+	}
+	return &KMPSearcher[T, It]{pattern: p, fail: fail, eq: eq}
+}
+
+func (s *KMPSearcher[T, It]) Search(first, last It) (It, It) {
+	if len(s.pattern) == 0 {
+		return first, first
+	}
+	// start follows it by the q matched elements.
+	start, q := first, 0
+	for it := first; !__iter_eq(it, last); it = it.Next() {
+		v := it.Read()
+		for q > 0 && !s.eq(v, s.pattern[q]) {
+			k := s.fail[q-1]
+			start, q = AdvanceN[T](start, q-k), k
+		}
+		if s.eq(v, s.pattern[q]) {
+			q++
+		} else {
+			start = start.Next()
+		}
+		if q == len(s.pattern) {
+			return start, it.Next()
+		}
+	}
+	return last, last
+}
+
+// BoyerMooreSearcher is a Searcher using the Boyer-Moore algorithm with the bad
+// character and the strong good suffix rules. It usually skips most elements
+// of the searched range for long patterns, and takes O(n·m) time in the worst
+// case.
+type BoyerMooreSearcher[T any, K comparable, It RandomReader[T, It]] struct {
+	pattern []T
+	hash    func(T) K
+	eq      EqComparer[T, T]
+	last    map[K]int // last index of each hash in the pattern
+	shift   []int     // shift[j] is the good suffix shift after a mismatch at j-1
+}
+
+// NewBoyerMooreSearcher returns a BoyerMooreSearcher for the pattern [sFirst,
+// sLast). The pattern is copied.
+func NewBoyerMooreSearcher[It RandomReader[T, It], T comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt) *BoyerMooreSearcher[T, T, It] {
+	return NewBoyerMooreSearcherBy[It](sFirst, sLast, __noop[T], __eq2[T])
+}
+
+// NewBoyerMooreSearcherBy returns a BoyerMooreSearcher for the pattern [sFirst,
+// sLast). The pattern is copied.
+//
+// Elements are compared using the given binary comparer eq, which must be an
+// equivalence relation. Elements that are equal by eq must have the same hash.
+func NewBoyerMooreSearcherBy[It RandomReader[T, It], T any, K comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt, hash func(T) K, eq EqComparer[T, T]) *BoyerMooreSearcher[T, K, It] {
+	p := __collect(sFirst, sLast)
+	m := len(p)
+	last := make(map[K]int, m)
+	for i, v := range p {
+		last[hash(v)] = i
+	}
+	// bpos[i] is the start of the widest border of p[i:].
+	shift, bpos := make([]int, m+1), make([]int, m+1)
+	i, j := m, m+1
+	bpos[i] = j
+	for i > 0 {
+		for j <= m && !eq(p[i-1], p[j-1]) {
+			if shift[j] == 0 {
+				shift[j] = j - i
+			}
+			j = bpos[j]
+		}
+		i, j = i-1, j-1
+		bpos[i] = j
+	}
+	for i, j = 0, bpos[0]; i <= m; i++ {
+		if shift[i] == 0 {
+			shift[i] = j
+		}
+		if i == j {
+			j = bpos[j]
+		}
+	}
+	return &BoyerMooreSearcher[T, K, It]{pattern: p, hash: hash, eq: eq, last: last, shift: shift}
+}
+
+func (s *BoyerMooreSearcher[T, K, It]) Search(first, last It) (It, It) {
+	m, n := len(s.pattern), first.Distance(last)
+	for i := 0; i+m <= n; {
+		j := m - 1
+		var v T
+		for ; j >= 0; j-- {
+			if v = first.AdvanceN(i + j).Read(); !s.eq(v, s.pattern[j]) {
+				break
+			}
+		}
+		if j < 0 {
+			mFirst := first.AdvanceN(i)
+			return mFirst, mFirst.AdvanceN(m)
+		}
+		bad := j + 1
+		if k, ok := s.last[s.hash(v)]; ok {
+			bad = j - k
+		}
+		i += max(bad, s.shift[j+1])
+	}
+	return last, last
+}
+
+// BoyerMooreHorspoolSearcher is a Searcher using the Boyer-Moore-Horspool
+// algorithm. It needs less preprocessing and space than BoyerMooreSearcher and
+// is usually as fast for large alphabets, but takes O(n·m) time in the worst
+// case.
+type BoyerMooreHorspoolSearcher[T any, K comparable, It RandomReader[T, It]] struct {
+	pattern []T
+	hash    func(T) K
+	eq      EqComparer[T, T]
+	skip    map[K]int
+}
+
+// NewBoyerMooreHorspoolSearcher returns a BoyerMooreHorspoolSearcher for the
+// pattern [sFirst, sLast). The pattern is copied.
+func NewBoyerMooreHorspoolSearcher[It RandomReader[T, It], T comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt) *BoyerMooreHorspoolSearcher[T, T, It] {
+	return NewBoyerMooreHorspoolSearcherBy[It](sFirst, sLast, __noop[T], __eq2[T])
+}
+
+// NewBoyerMooreHorspoolSearcherBy returns a BoyerMooreHorspoolSearcher for the
+// pattern [sFirst, sLast). The pattern is copied.
+//
+// Elements are compared using the given binary comparer eq, which must be an
+// equivalence relation. Elements that are equal by eq must have the same hash.
+func NewBoyerMooreHorspoolSearcherBy[It RandomReader[T, It], T any, K comparable, PIt ForwardReader[T, PIt]](sFirst, sLast PIt, hash func(T) K, eq EqComparer[T, T]) *BoyerMooreHorspoolSearcher[T, K, It] {
+	p := __collect(sFirst, sLast)
+	skip := make(map[K]int, len(p))
+	for i := 0; i < len(p)-1; i++ {
+		skip[hash(p[i])] = len(p) - 1 - i
+	}
+	return &BoyerMooreHorspoolSearcher[T, K, It]{pattern: p, hash: hash, eq: eq, skip: skip}
+}
+
+func (s *BoyerMooreHorspoolSearcher[T, K, It]) Search(first, last It) (It, It) {
+	m, n := len(s.pattern), first.Distance(last)
+	if m == 0 {
+		return first, first
+	}
+	for i := 0; i+m <= n; {
+		j := m - 1
+		for j >= 0 && s.eq(first.AdvanceN(i+j).Read(), s.pattern[j]) {
+			j--
+		}
+		if j < 0 {
+			mFirst := first.AdvanceN(i)
+			return mFirst, mFirst.AdvanceN(m)
+		}
+		if k, ok := s.skip[s.hash(first.AdvanceN(i+m-1).Read())]; ok {
+			i += k
+		} else {
+			i += m
+		}
+	}
+	return last, last
+}
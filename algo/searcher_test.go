@@ -0,0 +1,110 @@
+package algo_test
+
+import (
+	"container/list"
+	"strings"
+	"testing"
+
+	. "github.com/disksing/iter/v2"
+	. "github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+// indexAll returns the start positions of all, possibly overlapping,
+// occurrences of a pattern of length m in s found by repeated searches.
+func indexAll(s []byte, m int, sr Searcher[slices.Iterator[byte]]) []int {
+	var ret []int
+	begin, last := _first_byte(s), _last_byte(s)
+	for first := begin; ; first = first.Next() {
+		mFirst, _ := sr.Search(first, last)
+		if mFirst.Eq(last) {
+			if m == 0 {
+				ret = append(ret, len(s))
+			}
+			return ret
+		}
+		ret = append(ret, begin.Distance(mFirst))
+		first = mFirst
+	}
+}
+
+func TestSearchers(t *testing.T) {
+	assert := assert.New(t)
+	for i := 0; i < 500; i++ {
+		s, p := []byte(strings.Repeat(randString(), 1+r.Intn(3))), make([]byte, r.Intn(6))
+		for j := range s {
+			s[j] = 'a' + s[j]%3
+		}
+		for j := range p {
+			p[j] = 'a' + byte(r.Intn(3))
+		}
+		var want []int
+		for j := 0; j+len(p) <= len(s); j++ {
+			if string(s[j:j+len(p)]) == string(p) {
+				want = append(want, j)
+			}
+		}
+		pFirst, pLast := _first_byte(p), _last_byte(p)
+		for _, sr := range []Searcher[slices.Iterator[byte]]{
+			NewDefaultSearcher[slices.Iterator[byte]](pFirst, pLast),
+			NewKMPSearcher[slices.Iterator[byte]](pFirst, pLast),
+			NewBoyerMooreSearcher[slices.Iterator[byte]](pFirst, pLast),
+			NewBoyerMooreHorspoolSearcher[slices.Iterator[byte]](pFirst, pLast),
+		} {
+			assert.Equal(want, indexAll(s, len(p), sr), "%T %q in %q", sr, p, s)
+			first, last := _first_byte(s), _last_byte(s)
+			if len(want) > 0 {
+				mFirst, mLast := sr.Search(first, last)
+				assert.Equal(want[0], first.Distance(mFirst))
+				assert.Equal(len(p), mFirst.Distance(mLast))
+				assert.True(SearchWith(first, last, sr).Eq(mFirst))
+			} else {
+				assert.True(SearchWith(first, last, sr).Eq(last))
+			}
+		}
+	}
+}
+
+func TestSearchersBy(t *testing.T) {
+	assert := assert.New(t)
+	s := []rune("Nobody expects the Spanish Inquisition! NOBODY expects the spanish inquisition")
+	p := []rune("SPANISH")
+	lower := func(c rune) rune { return []rune(strings.ToLower(string(c)))[0] }
+	eq := func(x, y rune) bool { return lower(x) == lower(y) }
+	pFirst, pLast := slices.Begin(p), slices.End(p)
+	for _, sr := range []Searcher[slices.Iterator[rune]]{
+		NewDefaultSearcherBy[slices.Iterator[rune]](pFirst, pLast, eq),
+		NewKMPSearcherBy[slices.Iterator[rune]](pFirst, pLast, eq),
+		NewBoyerMooreSearcherBy[slices.Iterator[rune]](pFirst, pLast, lower, eq),
+		NewBoyerMooreHorspoolSearcherBy[slices.Iterator[rune]](pFirst, pLast, lower, eq),
+		// a poor hash is slow but still correct
+		NewBoyerMooreSearcherBy[slices.Iterator[rune]](pFirst, pLast, func(rune) bool { return true }, eq),
+		NewBoyerMooreHorspoolSearcherBy[slices.Iterator[rune]](pFirst, pLast, func(c rune) rune { return c % 2 }, eq),
+	} {
+		first, last := slices.Begin(s), slices.End(s)
+		mFirst, mLast := sr.Search(first, last)
+		assert.Equal(19, first.Distance(mFirst))
+		assert.Equal("Spanish", string(s[first.Distance(mFirst):first.Distance(mLast)]))
+		mFirst, _ = sr.Search(mLast, last)
+		assert.Equal(59, first.Distance(mFirst))
+		mFirst, mLast = sr.Search(mFirst.Next(), last)
+		assert.True(mFirst.Eq(last) && mLast.Eq(last))
+	}
+}
+
+func TestKMPSearcherForward(t *testing.T) {
+	assert := assert.New(t)
+	l := list.New()
+	for _, v := range []int{1, 2, 1, 2, 1, 2, 3, 1, 2, 3} {
+		l.PushBack(v)
+	}
+	end := &forwardListIter[int]{l: l}
+	p := []int{1, 2, 1, 2, 3}
+	sr := NewKMPSearcher[*forwardListIter[int]](_first_int(p), _last_int(p))
+	mFirst, mLast := sr.Search(forwardListBegin[int](l), end)
+	assert.Equal(2, Distance[int](forwardListBegin[int](l), mFirst))
+	assert.Equal(7, Distance[int](forwardListBegin[int](l), mLast))
+	mFirst, mLast = sr.Search(mFirst.Next(), end)
+	assert.True(mFirst.Eq(end) && mLast.Eq(end))
+}
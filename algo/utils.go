@@ -82,6 +82,12 @@ func __eq_bind1[T1, T2 any](p EqComparer[T1, T2], v T1) UnaryPredicate[T2] {
 	}
 }
 
+func __eq_bind2[T1, T2 any](p EqComparer[T1, T2], v T2) UnaryPredicate[T1] {
+	return func(x T1) bool {
+		return p(x, v)
+	}
+}
+
 func __not1[T any](p UnaryPredicate[T]) UnaryPredicate[T] {
 	return func(x T) bool { return !p(x) }
 }
@@ -93,3 +99,11 @@ func __less[T Ordered](v1, v2 T) bool {
 func __true1[T any](T) bool { return true }
 
 func __noop[T any](x T) T { return x }
+
+func __collect[T any, It ForwardReader[T, It]](first, last It) []T {
+	var s []T
+	for ; !__iter_eq(first, last); first = first.Next() {
+		s = append(s, first.Read())
+	}
+	return s
+}
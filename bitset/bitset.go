@@ -0,0 +1,215 @@
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+const wordSize = 64
+
+// Bitset is a sequence of bits packed into 64-bit words.
+//
+// A Bitset created by New has a fixed length unless PushBack or Resize is
+// called. The zero value is an empty Bitset ready to grow.
+type Bitset struct {
+	words []uint64
+	n     int
+}
+
+// New returns a Bitset of n bits, all cleared.
+func New(n int) *Bitset {
+	return &Bitset{words: make([]uint64, (n+wordSize-1)/wordSize), n: n}
+}
+
+// Len returns the number of bits.
+func (b *Bitset) Len() int {
+	return b.n
+}
+
+func (b *Bitset) check(i int) {
+	if i < 0 || i >= b.n {
+		panic(fmt.Sprintf("bitset: index %d out of range [0, %d)", i, b.n))
+	}
+}
+
+// Test reports whether bit i is set.
+func (b *Bitset) Test(i int) bool {
+	b.check(i)
+	return b.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Set sets bit i to v.
+func (b *Bitset) Set(i int, v bool) {
+	b.check(i)
+	if v {
+		b.words[i/wordSize] |= 1 << (i % wordSize)
+	} else {
+		b.words[i/wordSize] &^= 1 << (i % wordSize)
+	}
+}
+
+// Flip toggles bit i.
+func (b *Bitset) Flip(i int) {
+	b.check(i)
+	b.words[i/wordSize] ^= 1 << (i % wordSize)
+}
+
+// PushBack appends a bit.
+func (b *Bitset) PushBack(v bool) {
+	b.Resize(b.n + 1)
+	b.Set(b.n-1, v)
+}
+
+// Resize changes the number of bits to n. New bits are cleared.
+func (b *Bitset) Resize(n int) {
+	if n < b.n {
+		b.fill(n, b.n, false)
+	}
+	nw := (n + wordSize - 1) / wordSize
+	if nw <= cap(b.words) {
+		b.words = b.words[:nw]
+	} else {
+		b.words = append(b.words[:cap(b.words)], make([]uint64, nw-cap(b.words))...)
+	}
+	b.n = n
+}
+
+// Clone returns a copy of b.
+func (b *Bitset) Clone() *Bitset {
+	return &Bitset{words: append([]uint64(nil), b.words...), n: b.n}
+}
+
+// Count returns the number of set bits.
+func (b *Bitset) Count() int {
+	var c int
+	for _, w := range b.words {
+		c += bits.OnesCount64(w)
+	}
+	return c
+}
+
+// And sets b to the intersection of b and x and returns b. Bits of b beyond
+// the length of x are cleared.
+func (b *Bitset) And(x *Bitset) *Bitset {
+	for i := range b.words {
+		if i < len(x.words) {
+			b.words[i] &= x.words[i]
+		} else {
+			b.words[i] = 0
+		}
+	}
+	return b
+}
+
+// AndNot clears the bits of b that are set in x and returns b.
+func (b *Bitset) AndNot(x *Bitset) *Bitset {
+	for i := 0; i < len(b.words) && i < len(x.words); i++ {
+		b.words[i] &^= x.words[i]
+	}
+	return b
+}
+
+// Or sets b to the union of b and x and returns b. b grows to the length of x
+// if x is longer.
+func (b *Bitset) Or(x *Bitset) *Bitset {
+	if b.n < x.n {
+		b.Resize(x.n)
+	}
+	for i, w := range x.words {
+		b.words[i] |= w
+	}
+	return b
+}
+
+// Xor sets b to the symmetric difference of b and x and returns b. b grows to
+// the length of x if x is longer.
+func (b *Bitset) Xor(x *Bitset) *Bitset {
+	if b.n < x.n {
+		b.Resize(x.n)
+	}
+	for i, w := range x.words {
+		b.words[i] ^= w
+	}
+	return b
+}
+
+// Equal reports whether b and x have the same length and bits.
+func (b *Bitset) Equal(x *Bitset) bool {
+	if b.n != x.n {
+		return false
+	}
+	for i, w := range b.words {
+		if w != x.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the bits as a string of '0' and '1', with bit 0 first.
+func (b *Bitset) String() string {
+	var sb strings.Builder
+	for i := 0; i < b.n; i++ {
+		if b.Test(i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// mask returns a word with bits [lo, hi) set, where 0 <= lo <= hi <= 64.
+func mask(lo, hi int) uint64 {
+	return (^uint64(0) >> (wordSize - (hi - lo))) << lo
+}
+
+// chunks calls f with the word index and the mask of every word that
+// intersects bits [lo, hi). It stops when f returns false.
+func chunks(lo, hi int, f func(w int, m uint64) bool) {
+	for lo < hi {
+		w := lo / wordSize
+		end := min(hi, (w+1)*wordSize)
+		if !f(w, mask(lo-w*wordSize, end-w*wordSize)) {
+			return
+		}
+		lo = end
+	}
+}
+
+func (b *Bitset) count(lo, hi int) int {
+	var c int
+	chunks(lo, hi, func(w int, m uint64) bool {
+		c += bits.OnesCount64(b.words[w] & m)
+		return true
+	})
+	return c
+}
+
+func (b *Bitset) fill(lo, hi int, v bool) {
+	chunks(lo, hi, func(w int, m uint64) bool {
+		if v {
+			b.words[w] |= m
+		} else {
+			b.words[w] &^= m
+		}
+		return true
+	})
+}
+
+func (b *Bitset) find(lo, hi int, v bool) int {
+	pos := hi
+	chunks(lo, hi, func(w int, m uint64) bool {
+		x := b.words[w]
+		if !v {
+			x = ^x
+		}
+		if x &= m; x != 0 {
+			pos = w*wordSize + bits.TrailingZeros64(x)
+			return false
+		}
+		return true
+	})
+	return pos
+}
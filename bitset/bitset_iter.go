@@ -0,0 +1,138 @@
+package bitset
+
+import (
+	"fmt"
+
+	"github.com/disksing/iter/v2/algo"
+)
+
+// Iterator is a random-access iterator over the bits of a Bitset. Read and
+// Write access a single bit as a bool.
+type Iterator struct {
+	b    *Bitset
+	i    int
+	step int
+}
+
+// Begin returns an iterator to the first bit.
+func (b *Bitset) Begin() Iterator {
+	return Iterator{b: b, i: 0, step: 1}
+}
+
+// End returns an iterator to the passed last bit.
+func (b *Bitset) End() Iterator {
+	return Iterator{b: b, i: b.n, step: 1}
+}
+
+// RBegin returns an iterator to the last bit.
+func (b *Bitset) RBegin() Iterator {
+	return Iterator{b: b, i: b.n - 1, step: -1}
+}
+
+// REnd returns an iterator to the passed first bit.
+func (b *Bitset) REnd() Iterator {
+	return Iterator{b: b, i: -1, step: -1}
+}
+
+func (it Iterator) String() string {
+	dir := "->"
+	if it.step < 0 {
+		dir = "<-"
+	}
+	return fmt.Sprintf("%s@%d%s", it.b, it.i, dir)
+}
+
+// Index returns the position of the current bit.
+func (it Iterator) Index() int {
+	return it.i
+}
+
+func (it Iterator) Read() bool {
+	return it.b.Test(it.i)
+}
+
+func (it Iterator) Write(v bool) {
+	it.b.Set(it.i, v)
+}
+
+func (it Iterator) Eq(it2 Iterator) bool {
+	return it.i == it2.i
+}
+
+func (it Iterator) Less(it2 Iterator) bool {
+	if it.step < 0 {
+		return it.i > it2.i
+	}
+	return it.i < it2.i
+}
+
+func (it Iterator) AllowMultiplePass() {}
+
+func (it Iterator) Next() Iterator {
+	return it.AdvanceN(1)
+}
+
+func (it Iterator) Prev() Iterator {
+	return it.AdvanceN(-1)
+}
+
+func (it Iterator) AdvanceN(n int) Iterator {
+	return Iterator{
+		b:    it.b,
+		i:    it.i + n*it.step,
+		step: it.step,
+	}
+}
+
+func (it Iterator) Distance(it2 Iterator) int {
+	return (it2.i - it.i) * it.step
+}
+
+// BackInserter is an output iterator that appends bits to a Bitset.
+type BackInserter struct {
+	b *Bitset
+}
+
+// Appender returns an OutputIter to append bits to the back of the Bitset.
+func Appender(b *Bitset) BackInserter {
+	return BackInserter{b: b}
+}
+
+func (bi BackInserter) Write(v bool) {
+	bi.b.PushBack(v)
+}
+
+// forward reports whether [first, last) is a forward range, which can be handled
+// a word at a time.
+func forward(first, last Iterator) bool {
+	return first.step > 0 && last.step > 0
+}
+
+// Count counts the bits in the range [first, last) that are equal to v.
+func Count(first, last Iterator, v bool) int {
+	if !forward(first, last) {
+		return algo.Count(first, last, v)
+	}
+	c := first.b.count(first.i, last.i)
+	if !v {
+		c = first.Distance(last) - c
+	}
+	return c
+}
+
+// Fill assigns v to all bits in the range [first, last).
+func Fill(first, last Iterator, v bool) {
+	if !forward(first, last) {
+		algo.Fill(first, last, v)
+		return
+	}
+	first.b.fill(first.i, last.i, v)
+}
+
+// Find returns the first bit in the range [first, last) that is equal to v.
+func Find(first, last Iterator, v bool) Iterator {
+	if !forward(first, last) {
+		return algo.Find(first, last, v)
+	}
+	return Iterator{b: first.b, i: first.b.find(first.i, last.i, v), step: 1}
+}
@@ -0,0 +1,135 @@
+package bitset_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/bitset"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.RandomReadWriter[bool, Iterator] = Iterator{}
+	_ iter.OutputIter[bool]                 = BackInserter{}
+)
+
+func fromString(s string) *Bitset {
+	var b Bitset
+	for i := 0; i < len(s); i++ {
+		b.PushBack(s[i] == '1')
+	}
+	return &b
+}
+
+func TestBitset(t *testing.T) {
+	assert := assert.New(t)
+	b := New(70)
+	assert.Equal(70, b.Len())
+	b.Set(3, true)
+	b.Set(65, true)
+	b.Flip(69)
+	assert.True(b.Test(3))
+	assert.True(b.Test(69))
+	assert.False(b.Test(4))
+	assert.Equal(3, b.Count())
+	b.Set(3, false)
+	assert.Equal(2, b.Count())
+	assert.Panics(func() { b.Test(70) })
+	assert.Panics(func() { b.Set(-1, true) })
+
+	b.Resize(66)
+	assert.Equal(1, b.Count())
+	b.Resize(200)
+	assert.Equal(1, b.Count())
+	assert.False(b.Test(69))
+
+	c := b.Clone()
+	c.Flip(0)
+	assert.False(b.Test(0))
+	assert.False(b.Equal(c))
+	c.Flip(0)
+	assert.True(b.Equal(c))
+	assert.False(b.Equal(New(3)))
+
+	assert.Equal("0110", fromString("0110").String())
+}
+
+func TestSetAlgebra(t *testing.T) {
+	assert := assert.New(t)
+	x, y := "1100", "1010"
+	assert.Equal("1000", fromString(x).And(fromString(y)).String())
+	assert.Equal("1110", fromString(x).Or(fromString(y)).String())
+	assert.Equal("0110", fromString(x).Xor(fromString(y)).String())
+	assert.Equal("0100", fromString(x).AndNot(fromString(y)).String())
+
+	assert.Equal("1000", fromString("1111").And(fromString("1")).String())
+	assert.Equal("0111", fromString("1111").AndNot(fromString("1")).String())
+	assert.Equal("11011", fromString("1").Or(fromString("01011")).String())
+	assert.Equal("00011", fromString("1").Xor(fromString("10011")).String())
+}
+
+func TestIterator(t *testing.T) {
+	assert := assert.New(t)
+	b := fromString("1011")
+
+	b.Begin().AllowMultiplePass()
+	assert.Contains(fmt.Sprint(b.Begin()), "->")
+	assert.Contains(fmt.Sprint(b.RBegin()), "<-")
+	assert.Equal(4, b.Begin().Distance(b.End()))
+	assert.Equal(4, b.RBegin().Distance(b.REnd()))
+	assert.True(b.Begin().Less(b.End()))
+	assert.True(b.RBegin().Less(b.REnd()))
+	assert.False(b.End().Less(b.Begin()))
+	assert.Equal(2, b.Begin().AdvanceN(2).Index())
+	assert.True(b.REnd().Prev().Read())
+	assert.False(b.REnd().Prev().Prev().Read())
+
+	algo.Reverse[bool](b.Begin(), b.End())
+	assert.Equal("1101", b.String())
+	algo.SortBy(b.Begin(), b.End(), func(x, y bool) bool { return !x && y })
+	assert.Equal("0111", b.String())
+	b.End().Prev().Write(false)
+	assert.Equal("0110", b.String())
+
+	var c Bitset
+	algo.Copy[bool](b.RBegin(), b.REnd(), Appender(&c))
+	assert.Equal("0110", c.String())
+
+	var bs []bool
+	algo.Copy[bool](b.Begin(), b.End(), slices.Appender(&bs))
+	assert.Equal([]bool{false, true, true, false}, bs)
+}
+
+func TestFastPaths(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 300; n++ {
+		b := New(n)
+		for i := 0; i < n; i++ {
+			b.Set(i, r.Intn(3) == 0)
+		}
+		lo := r.Intn(n + 1)
+		hi := lo + r.Intn(n-lo+1)
+		first, last := b.Begin().AdvanceN(lo), b.Begin().AdvanceN(hi)
+		for _, v := range []bool{true, false} {
+			assert.Equal(algo.Count(first, last, v), Count(first, last, v))
+			assert.True(algo.Find(first, last, v).Eq(Find(first, last, v)))
+		}
+		rfirst, rlast := b.REnd().AdvanceN(-hi), b.REnd().AdvanceN(-lo)
+		assert.Equal(algo.Count(rfirst, rlast, true), Count(rfirst, rlast, true))
+		assert.True(algo.Find(rfirst, rlast, true).Eq(Find(rfirst, rlast, true)))
+
+		want := b.Clone()
+		algo.Fill(want.Begin().AdvanceN(lo), want.Begin().AdvanceN(hi), true)
+		Fill(first, last, true)
+		assert.True(want.Equal(b))
+		assert.Equal(want.Count(), b.Count())
+		algo.Fill(want.REnd().AdvanceN(-hi), want.REnd().AdvanceN(-lo), false)
+		Fill(rfirst, rlast, false)
+		assert.True(want.Equal(b))
+	}
+}
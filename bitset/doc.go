@@ -0,0 +1,6 @@
+// Package bitset provides a packed bit container whose iterators read and
+// write individual bits as bool values.
+//
+// Count, Fill, and Find work a machine word at a time when they are given
+// forward iterators.
+package bitset
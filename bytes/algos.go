@@ -0,0 +1,47 @@
+package bytes
+
+import (
+	"bytes"
+
+	"github.com/disksing/iter/v2/algo"
+)
+
+// forward reports whether [first, last) is a forward range, which can be handled
+// by the bytes package directly.
+func forward(first, last Iterator) bool {
+	return first.step > 0 && last.step > 0
+}
+
+// Find returns the first element in the range [first, last) that is equal to
+// v, using bytes.IndexByte for forward ranges.
+func Find(first, last Iterator, v byte) Iterator {
+	if !forward(first, last) {
+		return algo.Find(first, last, v)
+	}
+	if i := bytes.IndexByte(first.s[first.i:last.i], v); i >= 0 {
+		return first.AdvanceN(i)
+	}
+	return last
+}
+
+// Search searches for the first occurrence of the sequence of elements
+// [sFirst, sLast) in the range [first, last), using bytes.Index for forward
+// ranges.
+func Search(first, last, sFirst, sLast Iterator) Iterator {
+	if !forward(first, last) || sFirst.step < 0 || sLast.step < 0 {
+		return algo.Search[byte](first, last, sFirst, sLast)
+	}
+	if i := bytes.Index(first.s[first.i:last.i], sFirst.s[sFirst.i:sLast.i]); i >= 0 {
+		return first.AdvanceN(i)
+	}
+	return last
+}
+
+// Count counts the elements in the range [first, last) that are equal to v,
+// using bytes.Count for forward ranges.
+func Count(first, last Iterator, v byte) int {
+	if !forward(first, last) {
+		return algo.Count(first, last, v)
+	}
+	return bytes.Count(first.s[first.i:last.i], []byte{v})
+}
@@ -0,0 +1,160 @@
+package bytes
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/disksing/iter/v2"
+)
+
+// Iterator is a random-access iterator that reads and writes the bytes in a
+// []byte.
+type Iterator struct {
+	s    []byte
+	i    int
+	step int
+}
+
+// Begin returns an iterator to the front element of the byte slice.
+func Begin(s []byte) Iterator {
+	return Iterator{s: s, i: 0, step: 1}
+}
+
+// End returns an iterator to the passed last element of the byte slice.
+func End(s []byte) Iterator {
+	return Iterator{s: s, i: len(s), step: 1}
+}
+
+// RBegin returns an iterator to the back element of the byte slice.
+func RBegin(s []byte) Iterator {
+	return Iterator{s: s, i: len(s) - 1, step: -1}
+}
+
+// REnd returns an iterator to the passed first element of the byte slice.
+func REnd(s []byte) Iterator {
+	return Iterator{s: s, i: -1, step: -1}
+}
+
+func (it Iterator) String() string {
+	dir := "->"
+	if it.step < 0 {
+		dir = "<-"
+	}
+	return fmt.Sprintf("%q@%d%s", it.s, it.i, dir)
+}
+
+func (it Iterator) Read() byte {
+	return it.s[it.i]
+}
+
+func (it Iterator) Write(v byte) {
+	it.s[it.i] = v
+}
+
+func (it Iterator) Eq(it2 Iterator) bool {
+	return it.i == it2.i
+}
+
+func (it Iterator) Less(it2 Iterator) bool {
+	if it.step < 0 {
+		return it.i > it2.i
+	}
+	return it.i < it2.i
+}
+
+func (it Iterator) AllowMultiplePass() {}
+
+func (it Iterator) Next() Iterator {
+	return it.AdvanceN(1)
+}
+
+func (it Iterator) Prev() Iterator {
+	return it.AdvanceN(-1)
+}
+
+func (it Iterator) AdvanceN(n int) Iterator {
+	return Iterator{
+		s:    it.s,
+		i:    it.i + n*it.step,
+		step: it.step,
+	}
+}
+
+func (it Iterator) Distance(it2 Iterator) int {
+	return (it2.i - it.i) * it.step
+}
+
+// byteWriter is implemented by both bytes.Buffer and bufio.Writer.
+type byteWriter interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+	WriteRune(r rune) (int, error)
+}
+
+// Inserter is an output iterator that writes values to a bytes.Buffer or a
+// bufio.Writer.
+//
+// Bytes, runes, []byte, and strings are written as they are. Other values are
+// formatted by fmt.Fprint. Write panics if the underlying writer returns an
+// error.
+type Inserter[T any] struct {
+	w         byteWriter
+	written   bool
+	delimiter string
+}
+
+// BufferInserter returns an OutputIter that writes values to a bytes.Buffer,
+// separated by delimiter.
+func BufferInserter[T any](b *bytes.Buffer, delimiter string) *Inserter[T] {
+	return &Inserter[T]{w: b, delimiter: delimiter}
+}
+
+// BufioInserter returns an OutputIter that writes values to a bufio.Writer,
+// separated by delimiter. The caller is responsible for flushing w.
+func BufioInserter[T any](w *bufio.Writer, delimiter string) *Inserter[T] {
+	return &Inserter[T]{w: w, delimiter: delimiter}
+}
+
+func (bi *Inserter[T]) Write(x T) {
+	var err error
+	if bi.written && bi.delimiter != "" {
+		if _, err = bi.w.WriteString(bi.delimiter); err != nil {
+			panic(err)
+		}
+	}
+	bi.written = true
+	switch v := any(x).(type) {
+	case byte:
+		err = bi.w.WriteByte(v)
+	case rune:
+		_, err = bi.w.WriteRune(v)
+	case []byte:
+		_, err = bi.w.Write(v)
+	case string:
+		_, err = bi.w.WriteString(v)
+	default:
+		_, err = fmt.Fprint(bi.w, x)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MakeBytes creates a byte slice from the range specified by [first, last). The
+// value type should be byte or rune; runes are encoded as UTF-8.
+func MakeBytes[T byte | rune, It iter.ForwardReader[T, It]](first, last It) []byte {
+	var b []byte
+	for ; !first.Eq(last); first = first.Next() {
+		switch v := any(first.Read()).(type) {
+		case byte:
+			b = append(b, v)
+		case rune:
+			b = utf8.AppendRune(b, v)
+		}
+	}
+	return b
+}
@@ -0,0 +1,108 @@
+package bytes_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/bytes"
+	"github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.RandomReadWriter[byte, Iterator] = Iterator{}
+	_ iter.OutputIter[int]                  = (*Inserter[int])(nil)
+)
+
+func TestByteIterator(t *testing.T) {
+	assert := assert.New(t)
+	b := []byte("abcdefg")
+
+	Begin(b).AllowMultiplePass()
+	assert.Contains(fmt.Sprint(Begin(b)), "->")
+	assert.Contains(fmt.Sprint(RBegin(b)), "<-")
+
+	assert.Equal(End(b).Prev().Read(), byte('g'))
+	assert.Equal(REnd(b).Prev().Read(), byte('a'))
+	assert.Equal(RBegin(b).AdvanceN(3).Read(), byte('d'))
+	assert.Equal(Begin(b).Distance(End(b)), len(b))
+	assert.Equal(RBegin(b).Distance(REnd(b)), len(b))
+	assert.True(Begin(b).Less(End(b)))
+	assert.True(RBegin(b).Less(REnd(b)))
+	assert.False(REnd(b).Less(RBegin(b)))
+
+	algo.Reverse[byte](Begin(b), End(b))
+	assert.Equal("gfedcba", string(b))
+	algo.Sort[byte](Begin(b), End(b))
+	assert.Equal("abcdefg", string(b))
+	Begin(b).Next().Write('B')
+	assert.Equal("aBcdefg", string(b))
+}
+
+func TestMakeBytes(t *testing.T) {
+	assert := assert.New(t)
+	s := "a香b"
+	assert.Equal([]byte(s), MakeBytes[rune](strs.RuneBegin(s), strs.RuneEnd(s)))
+	assert.Equal([]byte("b香a"), MakeBytes[rune](strs.RuneRBegin(s), strs.RuneREnd(s)))
+	b := []byte("xyz")
+	assert.Equal([]byte("zyx"), MakeBytes[byte](RBegin(b), REnd(b)))
+	assert.Nil(MakeBytes[byte](Begin(nil), End(nil)))
+}
+
+func TestInserter(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	algo.CopyN[int](iter.IotaReader(1), 3, BufferInserter[int](&buf, ", "))
+	assert.Equal("1, 2, 3", buf.String())
+
+	buf.Reset()
+	bi := BufferInserter[any](&buf, "")
+	algo.FillN[any](bi, 2, byte('a'))
+	algo.FillN[any](bi, 2, '香')
+	algo.FillN[any](bi, 1, []byte("b"))
+	algo.FillN[any](bi, 1, "c")
+	assert.Equal("aa香香bc", buf.String())
+
+	buf.Reset()
+	w := bufio.NewWriter(&buf)
+	s := "hello"
+	algo.ReverseCopy[byte](strs.Begin(s), strs.End(s), BufioInserter[byte](w, ""))
+	assert.NoError(w.Flush())
+	assert.Equal("olleh", buf.String())
+
+	fw := bufio.NewWriterSize(failWriter{}, 16)
+	assert.Panics(func() {
+		algo.FillN[string](BufioInserter[string](fw, "-"), 100, "x")
+	})
+}
+
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("fail") }
+
+func TestFastPaths(t *testing.T) {
+	assert := assert.New(t)
+	b := []byte("abracadabra")
+	p := []byte("cad")
+	q := []byte("xyz")
+
+	assert.Equal(4, Begin(b).Distance(Find(Begin(b), End(b), 'c')))
+	assert.True(Find(Begin(b), End(b), 'z').Eq(End(b)))
+	assert.True(Find(Begin(b).AdvanceN(5), End(b), 'c').Eq(End(b)))
+	assert.Equal(1, RBegin(b).Distance(Find(RBegin(b), REnd(b), 'r')))
+
+	assert.Equal(4, Begin(b).Distance(Search(Begin(b), End(b), Begin(p), End(p))))
+	assert.True(Search(Begin(b), End(b), Begin(q), End(q)).Eq(End(b)))
+	assert.True(Search(Begin(b), End(b), Begin(p), Begin(p)).Eq(Begin(b)))
+	assert.Equal(4, RBegin(b).Distance(Search(RBegin(b), REnd(b), RBegin(p), REnd(p))))
+
+	assert.Equal(5, Count(Begin(b), End(b), 'a'))
+	assert.Equal(5, Count(RBegin(b), REnd(b), 'a'))
+	assert.Equal(3, Count(Begin(b).AdvanceN(1), End(b).AdvanceN(-3), 'a'))
+}
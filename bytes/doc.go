@@ -0,0 +1,6 @@
+// Package bytes adapts []byte, bytes.Buffer, and bufio.Writer to the generic
+// iterator model.
+//
+// Find, Search, and Count use the bytes package of the standard library when
+// they are given forward iterators over the same slice.
+package bytes
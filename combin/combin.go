@@ -0,0 +1,105 @@
+package combin
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+)
+
+// Iterator is an input iterator over the arrangements of an enumeration. A nil
+// *Iterator is its end sentinel.
+type Iterator[T any] struct {
+	elems []T
+	idx   []int  // positions of the elements, the first k of which are read
+	mask  []bool // positions of the elements in a subset, if not nil
+	k     int
+	step  func() bool
+	done  bool
+}
+
+func newIterator[T any, It iter.ForwardReader[T, It]](first, last It, k int) *Iterator[T] {
+	var elems []T
+	algo.Copy[T](first, last, slices.Appender(&elems))
+	idx := make([]int, len(elems))
+	algo.Iota(slices.Begin(idx), slices.End(idx), 0)
+	return &Iterator[T]{elems: elems, idx: idx, k: k, done: k < 0 || k > len(elems)}
+}
+
+// CombinationsBegin returns an iterator to the first of the combinations of k
+// elements of the range [first, last). It yields n!/(k!(n-k)!) combinations,
+// each in the order of the range.
+func CombinationsBegin[T any, It iter.ForwardReader[T, It]](first, last It, k int) *Iterator[T] {
+	it := newIterator(first, last, k)
+	it.step = func() bool { return slices.NextCombination(it.idx, it.k) }
+	return it
+}
+
+// PermutationsBegin returns an iterator to the first of the permutations of the
+// elements of the range [first, last). It yields n! permutations.
+func PermutationsBegin[T any, It iter.ForwardReader[T, It]](first, last It) *Iterator[T] {
+	it := newIterator(first, last, 0)
+	it.k = len(it.elems)
+	it.step = func() bool { return slices.NextPermutation(it.idx) }
+	return it
+}
+
+// KPermutationsBegin returns an iterator to the first of the permutations of k
+// elements of the range [first, last). It yields n!/(n-k)! permutations.
+func KPermutationsBegin[T any, It iter.ForwardReader[T, It]](first, last It, k int) *Iterator[T] {
+	it := newIterator(first, last, k)
+	it.step = func() bool { return slices.NextKPermutation(it.idx, it.k) }
+	return it
+}
+
+// SubsetsBegin returns an iterator to the first of the subsets of the elements
+// of the range [first, last). It yields 2ⁿ subsets in binary reflected Gray
+// code order, starting with the empty one, so that each subset differs from the
+// previous one by exactly one element. Elements of a subset are in the order of
+// the range.
+func SubsetsBegin[T any, It iter.ForwardReader[T, It]](first, last It) *Iterator[T] {
+	it := newIterator(first, last, 0)
+	it.mask = make([]bool, len(it.elems))
+	it.step = func() bool { return slices.NextGraySubset(it.mask) }
+	return it
+}
+
+// End returns an iterator to the passed last arrangement of any enumeration.
+func End[T any]() *Iterator[T] {
+	return nil
+}
+
+func (it *Iterator[T]) atEnd() bool {
+	return it == nil || it.done
+}
+
+func (it *Iterator[T]) Eq(x *Iterator[T]) bool {
+	if e1, e2 := it.atEnd(), x.atEnd(); e1 || e2 {
+		return e1 && e2
+	}
+	return it == x
+}
+
+func (it *Iterator[T]) Next() *Iterator[T] {
+	if !it.step() {
+		it.done = true
+	}
+	return it
+}
+
+// Read returns the current arrangement as a new slice.
+func (it *Iterator[T]) Read() []T {
+	if it.mask != nil {
+		var ret []T
+		for i, in := range it.mask {
+			if in {
+				ret = append(ret, it.elems[i])
+			}
+		}
+		return ret
+	}
+	ret := make([]T, it.k)
+	for i, j := range it.idx[:it.k] {
+		ret[i] = it.elems[j]
+	}
+	return ret
+}
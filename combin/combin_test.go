@@ -0,0 +1,63 @@
+package combin_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/combin"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ iter.InputIter[[]int, *Iterator[int]] = &Iterator[int]{}
+
+func all(first *Iterator[byte]) string {
+	var s []string
+	algo.Transform(first, End[byte](), slices.Appender(&s), func(b []byte) string { return string(b) })
+	return strings.Join(s, " ")
+}
+
+func TestCombinations(t *testing.T) {
+	assert := assert.New(t)
+	s := "abcd"
+	assert.Equal("ab ac ad bc bd cd", all(CombinationsBegin[byte](strs.Begin(s), strs.End(s), 2)))
+	assert.Equal("abcd", all(CombinationsBegin[byte](strs.Begin(s), strs.End(s), 4)))
+	assert.Equal("", all(CombinationsBegin[byte](strs.Begin(s), strs.End(s), 0)))
+	assert.Equal(1, iter.Distance[[]byte](CombinationsBegin[byte](strs.Begin(s), strs.End(s), 0), End[byte]()))
+	assert.Equal(0, iter.Distance[[]byte](CombinationsBegin[byte](strs.Begin(s), strs.End(s), 5), End[byte]()))
+
+	// equal elements are distinguished by position
+	s = "aab"
+	assert.Equal("aa ab ab", all(CombinationsBegin[byte](strs.Begin(s), strs.End(s), 2)))
+}
+
+func TestPermutations(t *testing.T) {
+	assert := assert.New(t)
+	s := "cab"
+	assert.Equal("cab cba acb abc bca bac", all(PermutationsBegin[byte](strs.Begin(s), strs.End(s))))
+	assert.Equal("ca cb ac ab bc ba", all(KPermutationsBegin[byte](strs.Begin(s), strs.End(s), 2)))
+	assert.Equal(0, iter.Distance[[]byte](KPermutationsBegin[byte](strs.Begin(s), strs.End(s), -1), End[byte]()))
+
+	var ps []string
+	algo.Transform(PermutationsBegin[int](slices.Begin([]int{}), slices.End([]int{})), End[int](),
+		slices.Appender(&ps), func(p []int) string { return fmt.Sprint(p) })
+	assert.Equal([]string{"[]"}, ps)
+}
+
+func TestSubsets(t *testing.T) {
+	assert := assert.New(t)
+	s := "abc"
+	assert.Equal(" a ab b bc abc ac c", all(SubsetsBegin[byte](strs.Begin(s), strs.End(s))))
+
+	// the arrangements read are not shared
+	first := SubsetsBegin[byte](strs.Begin(s), strs.End(s)).Next()
+	a := first.Read()
+	a[0] = 'x'
+	assert.Equal("a", string(first.Read()))
+	assert.False(first.Eq(End[byte]()))
+	assert.True(first.Eq(first))
+}
@@ -0,0 +1,17 @@
+// Package combin provides input iterators that enumerate the combinations,
+// permutations, and subsets of the elements of a range, and views of the
+// Cartesian products of ranges.
+//
+// Each arrangement is read as a new slice, so it can be kept or modified by the
+// caller. Arrangements are chosen by position: equal elements at different
+// positions are treated as distinct, and the order of the enumeration is the
+// lexicographic order of the positions. The in-place step functions the
+// iterators are built on, such as algo.NextCombination and
+// algo.NextGraySubset, work on the element values instead.
+//
+// Product2, Product3, and ProductN yield the tuples of two, three, or any
+// number of ranges as a Pair, a Triple, or a new slice. Their iterators are
+// forward iterators over any forward ranges. RandomProduct2, RandomProduct3,
+// and RandomProductN take random-access ranges and have random-access
+// iterators.
+package combin
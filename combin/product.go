@@ -0,0 +1,266 @@
+package combin
+
+import (
+	"fmt"
+
+	"github.com/disksing/iter/v2"
+)
+
+// Pair is a tuple of two values.
+type Pair[T1, T2 any] struct {
+	First  T1
+	Second T2
+}
+
+// Triple is a tuple of three values.
+type Triple[T1, T2, T3 any] struct {
+	First  T1
+	Second T2
+	Third  T3
+}
+
+// Product2 is a view of the Cartesian product of two ranges. Its tuples are in
+// lexicographic order, that is, the last component varies fastest.
+type Product2[T1, T2 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2]] struct {
+	first1, last1 It1
+	first2        It2
+	n1, n2        int
+}
+
+// NewProduct2 returns the Cartesian product of the ranges [first1, last1) and
+// [first2, last2). It takes linear time unless both ranges are random-access.
+func NewProduct2[T1, T2 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2]](first1, last1 It1, first2, last2 It2) Product2[T1, T2, It1, It2] {
+	return Product2[T1, T2, It1, It2]{
+		first1: first1,
+		last1:  last1,
+		first2: first2,
+		n1:     iter.Distance[T1](first1, last1),
+		n2:     iter.Distance[T2](first2, last2),
+	}
+}
+
+// Size returns the number of tuples.
+func (p Product2[T1, T2, It1, It2]) Size() int {
+	return p.n1 * p.n2
+}
+
+// Begin returns an iterator to the first tuple.
+func (p Product2[T1, T2, It1, It2]) Begin() Product2Iterator[T1, T2, It1, It2] {
+	return Product2Iterator[T1, T2, It1, It2]{p: p, it1: p.first1, it2: p.first2}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p Product2[T1, T2, It1, It2]) End() Product2Iterator[T1, T2, It1, It2] {
+	return Product2Iterator[T1, T2, It1, It2]{p: p, k: p.Size(), it1: p.last1, it2: p.first2}
+}
+
+// Product2Iterator is a forward iterator over the tuples of a Product2. All
+// operations take constant time.
+type Product2Iterator[T1, T2 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2]] struct {
+	p   Product2[T1, T2, It1, It2]
+	k   int
+	it1 It1
+	it2 It2
+}
+
+// Index returns the position of the current tuple in the product.
+func (it Product2Iterator[T1, T2, It1, It2]) Index() int {
+	return it.k
+}
+
+func (it Product2Iterator[T1, T2, It1, It2]) Read() Pair[T1, T2] {
+	return Pair[T1, T2]{it.it1.Read(), it.it2.Read()}
+}
+
+func (it Product2Iterator[T1, T2, It1, It2]) Eq(it2 Product2Iterator[T1, T2, It1, It2]) bool {
+	return it.k == it2.k
+}
+
+func (it Product2Iterator[T1, T2, It1, It2]) AllowMultiplePass() {}
+
+func (it Product2Iterator[T1, T2, It1, It2]) Next() Product2Iterator[T1, T2, It1, It2] {
+	it.k++
+	if it.it2 = it.it2.Next(); it.k%it.p.n2 == 0 {
+		it.it1, it.it2 = it.it1.Next(), it.p.first2
+	}
+	return it
+}
+
+// Product3 is a view of the Cartesian product of three ranges. Its tuples are
+// in lexicographic order, that is, the last component varies fastest.
+type Product3[T1, T2, T3 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2], It3 iter.ForwardReader[T3, It3]] struct {
+	first1, last1 It1
+	first2        It2
+	first3        It3
+	n1, n2, n3    int
+}
+
+// NewProduct3 returns the Cartesian product of the ranges [first1, last1),
+// [first2, last2), and [first3, last3). It takes linear time unless all
+// ranges are random-access.
+func NewProduct3[T1, T2, T3 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2], It3 iter.ForwardReader[T3, It3]](first1, last1 It1, first2, last2 It2, first3, last3 It3) Product3[T1, T2, T3, It1, It2, It3] {
+	return Product3[T1, T2, T3, It1, It2, It3]{
+		first1: first1,
+		last1:  last1,
+		first2: first2,
+		first3: first3,
+		n1:     iter.Distance[T1](first1, last1),
+		n2:     iter.Distance[T2](first2, last2),
+		n3:     iter.Distance[T3](first3, last3),
+	}
+}
+
+// Size returns the number of tuples.
+func (p Product3[T1, T2, T3, It1, It2, It3]) Size() int {
+	return p.n1 * p.n2 * p.n3
+}
+
+// Begin returns an iterator to the first tuple.
+func (p Product3[T1, T2, T3, It1, It2, It3]) Begin() Product3Iterator[T1, T2, T3, It1, It2, It3] {
+	return Product3Iterator[T1, T2, T3, It1, It2, It3]{p: p, it1: p.first1, it2: p.first2, it3: p.first3}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p Product3[T1, T2, T3, It1, It2, It3]) End() Product3Iterator[T1, T2, T3, It1, It2, It3] {
+	return Product3Iterator[T1, T2, T3, It1, It2, It3]{p: p, k: p.Size(), it1: p.last1, it2: p.first2, it3: p.first3}
+}
+
+// Product3Iterator is a forward iterator over the tuples of a Product3. All
+// operations take constant time.
+type Product3Iterator[T1, T2, T3 any, It1 iter.ForwardReader[T1, It1], It2 iter.ForwardReader[T2, It2], It3 iter.ForwardReader[T3, It3]] struct {
+	p   Product3[T1, T2, T3, It1, It2, It3]
+	k   int
+	it1 It1
+	it2 It2
+	it3 It3
+}
+
+// Index returns the position of the current tuple in the product.
+func (it Product3Iterator[T1, T2, T3, It1, It2, It3]) Index() int {
+	return it.k
+}
+
+func (it Product3Iterator[T1, T2, T3, It1, It2, It3]) Read() Triple[T1, T2, T3] {
+	return Triple[T1, T2, T3]{it.it1.Read(), it.it2.Read(), it.it3.Read()}
+}
+
+func (it Product3Iterator[T1, T2, T3, It1, It2, It3]) Eq(it2 Product3Iterator[T1, T2, T3, It1, It2, It3]) bool {
+	return it.k == it2.k
+}
+
+func (it Product3Iterator[T1, T2, T3, It1, It2, It3]) AllowMultiplePass() {}
+
+func (it Product3Iterator[T1, T2, T3, It1, It2, It3]) Next() Product3Iterator[T1, T2, T3, It1, It2, It3] {
+	it.k++
+	if it.it3 = it.it3.Next(); it.k%it.p.n3 == 0 {
+		if it.it2, it.it3 = it.it2.Next(), it.p.first3; it.k%(it.p.n2*it.p.n3) == 0 {
+			it.it1, it.it2 = it.it1.Next(), it.p.first2
+		}
+	}
+	return it
+}
+
+// ProductN is a view of the Cartesian product of any number of ranges of the
+// same type. Its tuples are in lexicographic order, that is, the last
+// component varies fastest.
+type ProductN[T any, It iter.ForwardReader[T, It]] struct {
+	firsts, lasts []It
+	size          int
+}
+
+// NewProductN returns the Cartesian product of the ranges [firsts[i],
+// lasts[i]). It panics if firsts and lasts have different lengths. It takes
+// linear time unless the ranges are random-access.
+//
+// The product of no ranges has a single empty tuple.
+func NewProductN[T any, It iter.ForwardReader[T, It]](firsts, lasts []It) ProductN[T, It] {
+	if len(firsts) != len(lasts) {
+		panic(fmt.Sprintf("combin: %d firsts and %d lasts", len(firsts), len(lasts)))
+	}
+	p := ProductN[T, It]{
+		firsts: append([]It(nil), firsts...),
+		lasts:  append([]It(nil), lasts...),
+		size:   1,
+	}
+	for i := range firsts {
+		p.size *= iter.Distance[T](firsts[i], lasts[i])
+	}
+	return p
+}
+
+// Size returns the number of tuples.
+func (p ProductN[T, It]) Size() int {
+	return p.size
+}
+
+// Begin returns an iterator to the first tuple.
+func (p ProductN[T, It]) Begin() ProductNIterator[T, It] {
+	if p.size == 0 {
+		return p.End()
+	}
+	var d *digit[It]
+	for _, first := range p.firsts {
+		d = &digit[It]{it: first, next: d}
+	}
+	return ProductNIterator[T, It]{p: p, d: d}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p ProductN[T, It]) End() ProductNIterator[T, It] {
+	return ProductNIterator[T, It]{p: p, k: p.size}
+}
+
+// digit is the position in one range of a ProductNIterator. The digits form an
+// immutable list from the last range to the first, so that an iterator and its
+// copies share the digits that have not changed.
+type digit[It any] struct {
+	it   It
+	next *digit[It]
+}
+
+// advance moves the digit d of the i'th range to the next position, carrying
+// to the previous ranges on wrap-around.
+func (p ProductN[T, It]) advance(d *digit[It], i int) *digit[It] {
+	if x := d.it.Next(); !x.Eq(p.lasts[i]) {
+		return &digit[It]{it: x, next: d.next}
+	}
+	return &digit[It]{it: p.firsts[i], next: p.advance(d.next, i-1)}
+}
+
+// ProductNIterator is a forward iterator over the tuples of a ProductN. Next
+// takes amortized constant time, and Read takes time linear in the number of
+// ranges.
+type ProductNIterator[T any, It iter.ForwardReader[T, It]] struct {
+	p ProductN[T, It]
+	k int
+	d *digit[It]
+}
+
+// Index returns the position of the current tuple in the product.
+func (it ProductNIterator[T, It]) Index() int {
+	return it.k
+}
+
+// Read returns the current tuple as a new slice.
+func (it ProductNIterator[T, It]) Read() []T {
+	ret := make([]T, len(it.p.firsts))
+	for i, d := len(ret)-1, it.d; i >= 0; i, d = i-1, d.next {
+		ret[i] = d.it.Read()
+	}
+	return ret
+}
+
+func (it ProductNIterator[T, It]) Eq(it2 ProductNIterator[T, It]) bool {
+	return it.k == it2.k
+}
+
+func (it ProductNIterator[T, It]) AllowMultiplePass() {}
+
+func (it ProductNIterator[T, It]) Next() ProductNIterator[T, It] {
+	if it.k++; it.k == it.p.size {
+		it.d = nil
+	} else {
+		it.d = it.p.advance(it.d, len(it.p.firsts)-1)
+	}
+	return it
+}
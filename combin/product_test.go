@@ -0,0 +1,205 @@
+package combin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/combin"
+	"github.com/disksing/iter/v2/forwardlist"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	sliceIt = slices.Iterator[int]
+	strIt   = strs.Iterator
+)
+
+var (
+	_ iter.ForwardReader[Pair[int, byte], Product2Iterator[int, byte, sliceIt, strIt]]                      = Product2Iterator[int, byte, sliceIt, strIt]{}
+	_ iter.ForwardReader[Triple[int, int, int], Product3Iterator[int, int, int, sliceIt, sliceIt, sliceIt]] = Product3Iterator[int, int, int, sliceIt, sliceIt, sliceIt]{}
+	_ iter.ForwardReader[[]int, ProductNIterator[int, sliceIt]]                                             = ProductNIterator[int, sliceIt]{}
+
+	_ iter.RandomReader[Pair[int, byte], RandomProduct2Iterator[int, byte, sliceIt, strIt]]                      = RandomProduct2Iterator[int, byte, sliceIt, strIt]{}
+	_ iter.RandomReader[Triple[int, int, int], RandomProduct3Iterator[int, int, int, sliceIt, sliceIt, sliceIt]] = RandomProduct3Iterator[int, int, int, sliceIt, sliceIt, sliceIt]{}
+	_ iter.RandomReader[[]int, RandomProductNIterator[int, sliceIt]]                                             = RandomProductNIterator[int, sliceIt]{}
+)
+
+func TestProduct2(t *testing.T) {
+	assert := assert.New(t)
+	a, s := []int{1, 2, 3}, "xy"
+	p := NewProduct2(slices.Begin(a), slices.End(a), strs.Begin(s), strs.End(s))
+	assert.Equal(6, p.Size())
+	var got []Pair[int, byte]
+	algo.Copy[Pair[int, byte]](p.Begin(), p.End(), slices.Appender(&got))
+	var want []Pair[int, byte]
+	for _, x := range a {
+		for i := range s {
+			want = append(want, Pair[int, byte]{x, s[i]})
+		}
+	}
+	assert.Equal(want, got)
+	assert.Equal(6, iter.Distance[Pair[int, byte]](p.Begin(), p.End()))
+	assert.Equal(4, iter.AdvanceN[Pair[int, byte]](p.Begin(), 4).Index())
+	_, ok := any(p.Begin()).(iter.BackwardMovable[Product2Iterator[int, byte, sliceIt, strIt]])
+	assert.False(ok)
+
+	empty := NewProduct2(slices.Begin(a), slices.End(a), strs.Begin(""), strs.End(""))
+	assert.Equal(0, empty.Size())
+	assert.True(empty.Begin().Eq(empty.End()))
+}
+
+func TestProduct3(t *testing.T) {
+	assert := assert.New(t)
+	a, b, c := []int{1, 2}, []int{3, 4, 5}, []int{6, 7}
+	p := NewProduct3(slices.Begin(a), slices.End(a), slices.Begin(b), slices.End(b), slices.Begin(c), slices.End(c))
+	assert.Equal(12, p.Size())
+	var want []Triple[int, int, int]
+	for _, x := range a {
+		for _, y := range b {
+			for _, z := range c {
+				want = append(want, Triple[int, int, int]{x, y, z})
+			}
+		}
+	}
+	var got []Triple[int, int, int]
+	algo.Copy[Triple[int, int, int]](p.Begin(), p.End(), slices.Appender(&got))
+	assert.Equal(want, got)
+}
+
+func TestProductN(t *testing.T) {
+	assert := assert.New(t)
+	ranges := [][]int{{0, 1}, {2}, {3, 4, 5}}
+	var firsts, lasts []sliceIt
+	for _, r := range ranges {
+		firsts, lasts = append(firsts, slices.Begin(r)), append(lasts, slices.End(r))
+	}
+	p := NewProductN(firsts, lasts)
+	assert.Equal(6, p.Size())
+	var got []string
+	algo.Transform(p.Begin(), p.End(), slices.Appender(&got), func(x []int) string { return fmt.Sprint(x) })
+	assert.Equal([]string{"[0 2 3]", "[0 2 4]", "[0 2 5]", "[1 2 3]", "[1 2 4]", "[1 2 5]"}, got)
+
+	// copies are not affected by advancing the original
+	it := p.Begin().Next()
+	cp := it
+	for i := 0; i < 4; i++ {
+		it = it.Next()
+	}
+	assert.Equal([]int{0, 2, 4}, cp.Read())
+	assert.Equal([]int{1, 2, 5}, it.Read())
+	assert.Equal([]int{1, 2, 3}, algo.MaxElementBy(p.Begin(), p.End(), func(x, y []int) bool {
+		return x[2] < y[2] || x[2] == y[2] && x[0] > y[0]
+	}).Next().Read())
+
+	// the tuples read are not shared
+	it = p.Begin()
+	it.Read()[0] = 9
+	assert.Equal([]int{0, 2, 3}, it.Read())
+
+	none := NewProductN[int, sliceIt](nil, nil)
+	assert.Equal(1, none.Size())
+	assert.Equal([]int{}, none.Begin().Read())
+	assert.True(none.Begin().Next().Eq(none.End()))
+
+	empty := NewProductN(append(firsts, slices.Begin([]int{})), append(lasts, slices.End([]int{})))
+	assert.Equal(0, empty.Size())
+	assert.True(empty.Begin().Eq(empty.End()))
+
+	assert.Panics(func() { NewProductN(firsts, lasts[:1]) })
+}
+
+func TestProductForward(t *testing.T) {
+	assert := assert.New(t)
+	l1, l2 := forwardlist.NewForwardList(1, 2, 3), forwardlist.NewForwardList(4, 5)
+	p := NewProduct2(l1.Begin(), l1.End(), l2.Begin(), l2.End())
+	assert.Equal(6, p.Size())
+	var got []Pair[int, int]
+	algo.Copy[Pair[int, int]](p.Begin(), p.End(), slices.Appender(&got))
+	assert.Equal([]Pair[int, int]{{1, 4}, {1, 5}, {2, 4}, {2, 5}, {3, 4}, {3, 5}}, got)
+
+	n := NewProductN([]forwardlist.Iterator[int]{l1.Begin(), l2.Begin()}, []forwardlist.Iterator[int]{l1.End(), l2.End()})
+	var tuples [][]int
+	algo.Copy[[]int](n.Begin(), n.End(), slices.Appender(&tuples))
+	assert.Equal([][]int{{1, 4}, {1, 5}, {2, 4}, {2, 5}, {3, 4}, {3, 5}}, tuples)
+}
+
+func TestRandomProduct2(t *testing.T) {
+	assert := assert.New(t)
+	a, s := []int{1, 2, 3}, "xy"
+	p := NewRandomProduct2(slices.Begin(a), slices.End(a), strs.Begin(s), strs.End(s))
+	assert.Equal(6, p.Size())
+	var want []Pair[int, byte]
+	f := NewProduct2(slices.Begin(a), slices.End(a), strs.Begin(s), strs.End(s))
+	algo.Copy[Pair[int, byte]](f.Begin(), f.End(), slices.Appender(&want))
+	var got []Pair[int, byte]
+	algo.Copy[Pair[int, byte]](p.Begin(), p.End(), slices.Appender(&got))
+	assert.Equal(want, got)
+
+	for i := 0; i <= p.Size(); i++ {
+		it := p.Begin().AdvanceN(i)
+		assert.Equal(i, it.Index())
+		assert.Equal(i, p.Begin().Distance(it))
+		if i < p.Size() {
+			assert.Equal(want[i], it.Read())
+		}
+		if i > 0 {
+			assert.Equal(want[i-1], it.Prev().Read())
+			assert.True(it.Prev().Less(it))
+		}
+	}
+	assert.True(p.Begin().AdvanceN(6).Eq(p.End()))
+	pos := algo.PartitionPoint(p.Begin(), p.End(), func(x Pair[int, byte]) bool { return x.First < 3 })
+	assert.Equal(4, pos.Index())
+
+	empty := NewRandomProduct2(slices.Begin(a), slices.End(a), strs.Begin(""), strs.End(""))
+	assert.Equal(0, empty.Size())
+	assert.True(empty.Begin().Eq(empty.End()))
+}
+
+func TestRandomProduct3(t *testing.T) {
+	assert := assert.New(t)
+	a, b, c := []int{1, 2}, []int{3, 4, 5}, []int{6, 7}
+	p := NewRandomProduct3(slices.Begin(a), slices.End(a), slices.Begin(b), slices.End(b), slices.Begin(c), slices.End(c))
+	assert.Equal(12, p.Size())
+	var want []Triple[int, int, int]
+	f := NewProduct3(slices.Begin(a), slices.End(a), slices.Begin(b), slices.End(b), slices.Begin(c), slices.End(c))
+	algo.Copy[Triple[int, int, int]](f.Begin(), f.End(), slices.Appender(&want))
+	var got []Triple[int, int, int]
+	algo.ReverseCopy[Triple[int, int, int]](p.Begin(), p.End(), slices.Appender(&got))
+	slices.Reverse(got)
+	assert.Equal(want, got)
+	for i := range want {
+		assert.Equal(want[i], p.End().AdvanceN(i-12).Read())
+	}
+}
+
+func TestRandomProductN(t *testing.T) {
+	assert := assert.New(t)
+	ranges := [][]int{{0, 1}, {2}, {3, 4, 5}}
+	var firsts, lasts []sliceIt
+	for _, r := range ranges {
+		firsts, lasts = append(firsts, slices.Begin(r)), append(lasts, slices.End(r))
+	}
+	p := NewRandomProductN(firsts, lasts)
+	assert.Equal(6, p.Size())
+	var got []string
+	algo.Transform(p.Begin(), p.End(), slices.Appender(&got), func(x []int) string { return fmt.Sprint(x) })
+	assert.Equal([]string{"[0 2 3]", "[0 2 4]", "[0 2 5]", "[1 2 3]", "[1 2 4]", "[1 2 5]"}, got)
+	assert.Equal([]int{1, 2, 4}, p.End().AdvanceN(-2).Read())
+	assert.Equal(got[3], fmt.Sprint(p.Begin().AdvanceN(4).Prev().Read()))
+
+	none := NewRandomProductN[int, sliceIt](nil, nil)
+	assert.Equal(1, none.Size())
+	assert.Equal([]int{}, none.Begin().Read())
+	assert.True(none.Begin().Next().Eq(none.End()))
+
+	empty := NewRandomProductN(append(firsts, slices.Begin([]int{})), append(lasts, slices.End([]int{})))
+	assert.Equal(0, empty.Size())
+	assert.True(empty.Begin().Eq(empty.End()))
+
+	assert.Panics(func() { NewRandomProductN(firsts, lasts[:1]) })
+}
@@ -0,0 +1,270 @@
+package combin
+
+import (
+	"fmt"
+
+	"github.com/disksing/iter/v2"
+)
+
+// RandomProduct2 is a view of the Cartesian product of two random-access
+// ranges, in the same order as Product2.
+type RandomProduct2[T1, T2 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2]] struct {
+	first1 It1
+	first2 It2
+	n1, n2 int
+}
+
+// NewRandomProduct2 returns the Cartesian product of the ranges [first1,
+// last1) and [first2, last2).
+func NewRandomProduct2[T1, T2 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2]](first1, last1 It1, first2, last2 It2) RandomProduct2[T1, T2, It1, It2] {
+	return RandomProduct2[T1, T2, It1, It2]{
+		first1: first1,
+		first2: first2,
+		n1:     first1.Distance(last1),
+		n2:     first2.Distance(last2),
+	}
+}
+
+// Size returns the number of tuples.
+func (p RandomProduct2[T1, T2, It1, It2]) Size() int {
+	return p.n1 * p.n2
+}
+
+// Begin returns an iterator to the first tuple.
+func (p RandomProduct2[T1, T2, It1, It2]) Begin() RandomProduct2Iterator[T1, T2, It1, It2] {
+	return RandomProduct2Iterator[T1, T2, It1, It2]{p: p}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p RandomProduct2[T1, T2, It1, It2]) End() RandomProduct2Iterator[T1, T2, It1, It2] {
+	return RandomProduct2Iterator[T1, T2, It1, It2]{p: p, k: p.Size()}
+}
+
+// RandomProduct2Iterator is a random-access iterator over the tuples of a
+// RandomProduct2. All operations take constant time.
+type RandomProduct2Iterator[T1, T2 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2]] struct {
+	p RandomProduct2[T1, T2, It1, It2]
+	k int
+}
+
+// Index returns the position of the current tuple in the product.
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Index() int {
+	return it.k
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Read() Pair[T1, T2] {
+	p := it.p
+	return Pair[T1, T2]{p.first1.AdvanceN(it.k / p.n2).Read(), p.first2.AdvanceN(it.k % p.n2).Read()}
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Eq(it2 RandomProduct2Iterator[T1, T2, It1, It2]) bool {
+	return it.k == it2.k
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Less(it2 RandomProduct2Iterator[T1, T2, It1, It2]) bool {
+	return it.k < it2.k
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) AllowMultiplePass() {}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Next() RandomProduct2Iterator[T1, T2, It1, It2] {
+	it.k++
+	return it
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Prev() RandomProduct2Iterator[T1, T2, It1, It2] {
+	it.k--
+	return it
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) AdvanceN(n int) RandomProduct2Iterator[T1, T2, It1, It2] {
+	it.k += n
+	return it
+}
+
+func (it RandomProduct2Iterator[T1, T2, It1, It2]) Distance(it2 RandomProduct2Iterator[T1, T2, It1, It2]) int {
+	return it2.k - it.k
+}
+
+// RandomProduct3 is a view of the Cartesian product of three random-access
+// ranges, in the same order as Product3.
+type RandomProduct3[T1, T2, T3 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2], It3 iter.RandomReader[T3, It3]] struct {
+	first1     It1
+	first2     It2
+	first3     It3
+	n1, n2, n3 int
+}
+
+// NewRandomProduct3 returns the Cartesian product of the ranges [first1,
+// last1), [first2, last2), and [first3, last3).
+func NewRandomProduct3[T1, T2, T3 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2], It3 iter.RandomReader[T3, It3]](first1, last1 It1, first2, last2 It2, first3, last3 It3) RandomProduct3[T1, T2, T3, It1, It2, It3] {
+	return RandomProduct3[T1, T2, T3, It1, It2, It3]{
+		first1: first1,
+		first2: first2,
+		first3: first3,
+		n1:     first1.Distance(last1),
+		n2:     first2.Distance(last2),
+		n3:     first3.Distance(last3),
+	}
+}
+
+// Size returns the number of tuples.
+func (p RandomProduct3[T1, T2, T3, It1, It2, It3]) Size() int {
+	return p.n1 * p.n2 * p.n3
+}
+
+// Begin returns an iterator to the first tuple.
+func (p RandomProduct3[T1, T2, T3, It1, It2, It3]) Begin() RandomProduct3Iterator[T1, T2, T3, It1, It2, It3] {
+	return RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]{p: p}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p RandomProduct3[T1, T2, T3, It1, It2, It3]) End() RandomProduct3Iterator[T1, T2, T3, It1, It2, It3] {
+	return RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]{p: p, k: p.Size()}
+}
+
+// RandomProduct3Iterator is a random-access iterator over the tuples of a
+// RandomProduct3. All operations take constant time.
+type RandomProduct3Iterator[T1, T2, T3 any, It1 iter.RandomReader[T1, It1], It2 iter.RandomReader[T2, It2], It3 iter.RandomReader[T3, It3]] struct {
+	p RandomProduct3[T1, T2, T3, It1, It2, It3]
+	k int
+}
+
+// Index returns the position of the current tuple in the product.
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Index() int {
+	return it.k
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Read() Triple[T1, T2, T3] {
+	p := it.p
+	return Triple[T1, T2, T3]{
+		p.first1.AdvanceN(it.k / (p.n2 * p.n3)).Read(),
+		p.first2.AdvanceN(it.k / p.n3 % p.n2).Read(),
+		p.first3.AdvanceN(it.k % p.n3).Read(),
+	}
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Eq(it2 RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) bool {
+	return it.k == it2.k
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Less(it2 RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) bool {
+	return it.k < it2.k
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) AllowMultiplePass() {}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Next() RandomProduct3Iterator[T1, T2, T3, It1, It2, It3] {
+	it.k++
+	return it
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Prev() RandomProduct3Iterator[T1, T2, T3, It1, It2, It3] {
+	it.k--
+	return it
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) AdvanceN(n int) RandomProduct3Iterator[T1, T2, T3, It1, It2, It3] {
+	it.k += n
+	return it
+}
+
+func (it RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) Distance(it2 RandomProduct3Iterator[T1, T2, T3, It1, It2, It3]) int {
+	return it2.k - it.k
+}
+
+// RandomProductN is a view of the Cartesian product of any number of
+// random-access ranges of the same type, in the same order as ProductN.
+type RandomProductN[T any, It iter.RandomReader[T, It]] struct {
+	firsts []It
+	sizes  []int
+	size   int
+}
+
+// NewRandomProductN returns the Cartesian product of the ranges [firsts[i],
+// lasts[i]). It panics if firsts and lasts have different lengths.
+//
+// The product of no ranges has a single empty tuple.
+func NewRandomProductN[T any, It iter.RandomReader[T, It]](firsts, lasts []It) RandomProductN[T, It] {
+	if len(firsts) != len(lasts) {
+		panic(fmt.Sprintf("combin: %d firsts and %d lasts", len(firsts), len(lasts)))
+	}
+	p := RandomProductN[T, It]{
+		firsts: append([]It(nil), firsts...),
+		sizes:  make([]int, len(firsts)),
+		size:   1,
+	}
+	for i := range firsts {
+		p.sizes[i] = firsts[i].Distance(lasts[i])
+		p.size *= p.sizes[i]
+	}
+	return p
+}
+
+// Size returns the number of tuples.
+func (p RandomProductN[T, It]) Size() int {
+	return p.size
+}
+
+// Begin returns an iterator to the first tuple.
+func (p RandomProductN[T, It]) Begin() RandomProductNIterator[T, It] {
+	return RandomProductNIterator[T, It]{p: p}
+}
+
+// End returns an iterator to the passed last tuple.
+func (p RandomProductN[T, It]) End() RandomProductNIterator[T, It] {
+	return RandomProductNIterator[T, It]{p: p, k: p.size}
+}
+
+// RandomProductNIterator is a random-access iterator over the tuples of a
+// RandomProductN. Read takes time linear in the number of ranges, and the
+// other operations take constant time.
+type RandomProductNIterator[T any, It iter.RandomReader[T, It]] struct {
+	p RandomProductN[T, It]
+	k int
+}
+
+// Index returns the position of the current tuple in the product.
+func (it RandomProductNIterator[T, It]) Index() int {
+	return it.k
+}
+
+// Read returns the current tuple as a new slice.
+func (it RandomProductNIterator[T, It]) Read() []T {
+	p := it.p
+	ret := make([]T, len(p.firsts))
+	for i, r := len(ret)-1, it.k; i >= 0; i-- {
+		ret[i] = p.firsts[i].AdvanceN(r % p.sizes[i]).Read()
+		r /= p.sizes[i]
+	}
+	return ret
+}
+
+func (it RandomProductNIterator[T, It]) Eq(it2 RandomProductNIterator[T, It]) bool {
+	return it.k == it2.k
+}
+
+func (it RandomProductNIterator[T, It]) Less(it2 RandomProductNIterator[T, It]) bool {
+	return it.k < it2.k
+}
+
+func (it RandomProductNIterator[T, It]) AllowMultiplePass() {}
+
+func (it RandomProductNIterator[T, It]) Next() RandomProductNIterator[T, It] {
+	it.k++
+	return it
+}
+
+func (it RandomProductNIterator[T, It]) Prev() RandomProductNIterator[T, It] {
+	it.k--
+	return it
+}
+
+func (it RandomProductNIterator[T, It]) AdvanceN(n int) RandomProductNIterator[T, It] {
+	it.k += n
+	return it
+}
+
+func (it RandomProductNIterator[T, It]) Distance(it2 RandomProductNIterator[T, It]) int {
+	return it2.k - it.k
+}
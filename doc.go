@@ -1,5 +1,14 @@
 // Package iter defines generic iterator capabilities and common adapters.
 //
-// The algo subpackage provides algorithms over iterator ranges. The slices,
-// lists, and strs subpackages adapt common Go containers to those algorithms.
+// The algo subpackage provides algorithms over iterator ranges, and the par
+// subpackage runs some of them in parallel over random-access ranges. The
+// slices, lists, strs, and bytes subpackages adapt common Go containers to
+// those algorithms.
+//
+// The bitset, forwardlist, ringbuffer, and trie subpackages provide a packed
+// bit container, a singly linked list, a circular buffer, and a prefix tree.
+// The adapter subpackage provides stacks and queues over pluggable storage,
+// the traverse subpackage iterates over user-defined trees and graphs, and the
+// combin subpackage enumerates combinations, permutations, subsets, and
+// Cartesian products.
 package iter
@@ -0,0 +1,8 @@
+// Package forwardlist provides ForwardList, a singly linked list like C++
+// std::forward_list.
+//
+// Each node holds a single link, so a ForwardList uses less memory than a
+// doubly linked list, but its iterators only move forward. Elements are
+// inserted and erased after a given position; BeforeBegin returns the
+// position before the first element for that purpose.
+package forwardlist
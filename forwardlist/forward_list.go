@@ -0,0 +1,172 @@
+package forwardlist
+
+type node[T any] struct {
+	next  *node[T]
+	value T
+}
+
+// ForwardList is a singly linked list whose nodes store values of type T. The
+// zero value is an empty list ready to use.
+type ForwardList[T any] struct {
+	head node[T] // before-begin node
+	len  int
+}
+
+// NewForwardList returns a list of the given values.
+func NewForwardList[T any](values ...T) *ForwardList[T] {
+	l := new(ForwardList[T])
+	pos := l.BeforeBegin()
+	for _, v := range values {
+		pos = l.InsertAfter(pos, v)
+	}
+	return l
+}
+
+// Len returns the number of elements.
+func (l *ForwardList[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element of the list. It panics if the list is
+// empty.
+func (l *ForwardList[T]) Front() T {
+	l.checkNotEmpty()
+	return l.head.next.value
+}
+
+func (l *ForwardList[T]) checkNotEmpty() {
+	if l.head.next == nil {
+		panic("forwardlist: empty list")
+	}
+}
+
+// BeforeBegin returns an iterator to the position before the first element of
+// the list. It can be passed to InsertAfter, EraseAfter, and SpliceAfter, but
+// must not be read or written.
+func (l *ForwardList[T]) BeforeBegin() Iterator[T] {
+	return Iterator[T]{n: &l.head}
+}
+
+// Begin returns an iterator to the first element of the list.
+func (l *ForwardList[T]) Begin() Iterator[T] {
+	return Iterator[T]{n: l.head.next}
+}
+
+// End returns an iterator to the passed last element of the list.
+func (l *ForwardList[T]) End() Iterator[T] {
+	return Iterator[T]{}
+}
+
+// PushFront inserts v at the front of the list and returns an iterator to it.
+func (l *ForwardList[T]) PushFront(v T) Iterator[T] {
+	return l.InsertAfter(l.BeforeBegin(), v)
+}
+
+// PopFront removes the first element of the list and returns it. It panics if
+// the list is empty.
+func (l *ForwardList[T]) PopFront() T {
+	l.checkNotEmpty()
+	v := l.head.next.value
+	l.EraseAfter(l.BeforeBegin())
+	return v
+}
+
+// InsertAfter inserts v after pos and returns an iterator to it.
+func (l *ForwardList[T]) InsertAfter(pos Iterator[T], v T) Iterator[T] {
+	n := &node[T]{next: pos.n.next, value: v}
+	pos.n.next = n
+	l.len++
+	return Iterator[T]{n: n}
+}
+
+// EraseAfter removes the element after pos and returns an iterator to the
+// element following the removed one. It panics if pos is the end iterator or
+// the last element.
+func (l *ForwardList[T]) EraseAfter(pos Iterator[T]) Iterator[T] {
+	if pos.n == nil {
+		panic("forwardlist: EraseAfter of the end iterator")
+	}
+	n := pos.n.next
+	if n == nil {
+		panic("forwardlist: EraseAfter at last element")
+	}
+	pos.n.next, n.next = n.next, nil
+	l.len--
+	return Iterator[T]{n: pos.n.next}
+}
+
+// EraseAfterRange removes the elements in the range (first, last) and returns
+// last.
+func (l *ForwardList[T]) EraseAfterRange(first, last Iterator[T]) Iterator[T] {
+	for first.n.next != last.n {
+		l.EraseAfter(first)
+	}
+	return last
+}
+
+// SpliceAfter moves the elements in the range (first, last) of other after pos
+// in l, without copying. other may be l itself, in which case pos must not be
+// in (first, last).
+//
+// SpliceAfter takes time linear in the number of moved elements, since the
+// element before last has to be found.
+func (l *ForwardList[T]) SpliceAfter(pos Iterator[T], other *ForwardList[T], first, last Iterator[T]) {
+	if first.n.next == last.n || pos.n == first.n {
+		return
+	}
+	head, tail, k := first.n.next, first.n.next, 1
+	for tail.next != last.n {
+		tail = tail.next
+		k++
+	}
+	if pos.n == tail {
+		return
+	}
+	// Detach (first, last) from other.
+	first.n.next = last.n
+	// Attach it after pos.
+	tail.next, pos.n.next = pos.n.next, head
+	if other != l {
+		other.len -= k
+		l.len += k
+	}
+}
+
+// Iterator is a forward iterator over a ForwardList.
+type Iterator[T any] struct {
+	n *node[T]
+}
+
+func (it Iterator[T]) Eq(x Iterator[T]) bool {
+	return it.n == x.n
+}
+
+func (it Iterator[T]) AllowMultiplePass() {}
+
+func (it Iterator[T]) Next() Iterator[T] {
+	return Iterator[T]{n: it.n.next}
+}
+
+func (it Iterator[T]) Read() T {
+	return it.n.value
+}
+
+func (it Iterator[T]) Write(v T) {
+	it.n.value = v
+}
+
+// Inserter is an output iterator that inserts values after a position of a
+// ForwardList, keeping them in the written order.
+type Inserter[T any] struct {
+	l   *ForwardList[T]
+	pos *Iterator[T]
+}
+
+// AfterInserter returns an OutputIter to insert elements after pos.
+func AfterInserter[T any](l *ForwardList[T], pos Iterator[T]) Inserter[T] {
+	return Inserter[T]{l: l, pos: &pos}
+}
+
+func (ins Inserter[T]) Write(v T) {
+	*ins.pos = ins.l.InsertAfter(*ins.pos, v)
+}
@@ -0,0 +1,114 @@
+package forwardlist_test
+
+import (
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/forwardlist"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.ForwardReadWriter[int, Iterator[int]] = Iterator[int]{}
+	_ iter.OutputIter[int]                       = Inserter[int]{}
+)
+
+func values[T any](l *ForwardList[T]) []T {
+	ret := []T{}
+	algo.Copy[T](l.Begin(), l.End(), slices.Appender(&ret))
+	if len(ret) != l.Len() {
+		panic("wrong length")
+	}
+	return ret
+}
+
+func TestForwardList(t *testing.T) {
+	assert := assert.New(t)
+	var l ForwardList[int]
+	assert.Equal(0, l.Len())
+	assert.True(l.Begin().Eq(l.End()))
+
+	l.PushFront(3)
+	it := l.PushFront(1)
+	l.InsertAfter(it, 2)
+	assert.Equal([]int{1, 2, 3}, values(&l))
+	assert.Equal(1, l.Front())
+
+	it.AllowMultiplePass()
+	it.Next().Write(20)
+	assert.Equal(3, l.EraseAfter(it).Read())
+	assert.Equal([]int{1, 3}, values(&l))
+	assert.True(l.EraseAfter(it).Eq(l.End()))
+	assert.Equal(1, l.PopFront())
+	assert.Equal(0, l.Len())
+
+	algo.CopyN[int](iter.IotaReader(1), 5, AfterInserter(&l, l.BeforeBegin()))
+	assert.Equal([]int{1, 2, 3, 4, 5}, values(&l))
+	assert.True(l.EraseAfterRange(l.Begin(), l.Begin().Next().Next().Next()).Eq(l.Begin().Next()))
+	assert.Equal([]int{1, 4, 5}, values(&l))
+	l.EraseAfterRange(l.BeforeBegin(), l.End())
+	assert.Equal([]int{}, values(&l))
+
+	_, bidi := any(it).(iter.BackwardMovable[Iterator[int]])
+	assert.False(bidi)
+}
+
+func TestForwardListEmpty(t *testing.T) {
+	assert := assert.New(t)
+	var zero ForwardList[int]
+	assert.PanicsWithValue("forwardlist: empty list", func() { zero.Front() })
+	assert.PanicsWithValue("forwardlist: empty list", func() { zero.PopFront() })
+	assert.PanicsWithValue("forwardlist: EraseAfter at last element", func() { zero.EraseAfter(zero.BeforeBegin()) })
+
+	l := NewForwardList(1, 2)
+	assert.PanicsWithValue("forwardlist: EraseAfter at last element", func() { l.EraseAfter(l.Begin().Next()) })
+	assert.PanicsWithValue("forwardlist: EraseAfter of the end iterator", func() { l.EraseAfter(l.End()) })
+	assert.Equal([]int{1, 2}, values(l))
+	assert.Equal(1, l.PopFront())
+	assert.Equal(2, l.PopFront())
+	assert.PanicsWithValue("forwardlist: empty list", func() { l.PopFront() })
+	assert.Equal(0, l.Len())
+}
+
+func TestForwardListSpliceAfter(t *testing.T) {
+	assert := assert.New(t)
+	a, b := NewForwardList(1, 2, 3), NewForwardList(10, 20, 30, 40)
+
+	a.SpliceAfter(a.Begin(), b, b.BeforeBegin(), b.Begin().Next())
+	assert.Equal([]int{1, 10, 2, 3}, values(a))
+	assert.Equal([]int{20, 30, 40}, values(b))
+
+	a.SpliceAfter(a.BeforeBegin(), b, b.Begin(), b.End())
+	assert.Equal([]int{30, 40, 1, 10, 2, 3}, values(a))
+	assert.Equal([]int{20}, values(b))
+
+	// same list
+	a.SpliceAfter(a.BeforeBegin(), a, a.Begin().Next(), a.End())
+	assert.Equal([]int{1, 10, 2, 3, 30, 40}, values(a))
+	a.SpliceAfter(a.Begin(), a, a.BeforeBegin(), a.Begin().Next())
+	a.SpliceAfter(a.Begin(), a, a.Begin(), a.End())
+	assert.Equal([]int{1, 10, 2, 3, 30, 40}, values(a))
+}
+
+func TestForwardListAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	l := NewForwardList(1, 2, 3, 4, 5, 6, 7)
+	algo.Rotate[int](l.Begin(), l.Begin().Next().Next(), l.End())
+	assert.Equal([]int{3, 4, 5, 6, 7, 1, 2}, values(l))
+
+	it := algo.StablePartition(l.Begin(), l.End(), func(x int) bool { return x%2 == 0 })
+	assert.Equal([]int{4, 6, 2, 3, 5, 7, 1}, values(l))
+	assert.Equal(3, it.Read())
+
+	l = NewForwardList(1, 1, 2, 2, 2, 3, 1, 1)
+	it = algo.Unique[int](l.Begin(), l.End())
+	assert.Equal(4, iter.Distance[int](l.Begin(), it))
+	prev := l.BeforeBegin()
+	for !prev.Next().Eq(it) {
+		prev = prev.Next()
+	}
+	l.EraseAfterRange(prev, l.End())
+	assert.Equal([]int{1, 2, 3, 1}, values(l))
+}
@@ -0,0 +1,153 @@
+package lists
+
+import (
+	"container/list"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// AllOf checks if unary predicate pred returns true for all elements.
+func AllOf[T any](l *list.List, pred algo.UnaryPredicate[T]) bool {
+	return algo.AllOf(Begin[T](l), End[T](l), pred)
+}
+
+// AnyOf checks if unary predicate pred returns true for at least one element.
+func AnyOf[T any](l *list.List, pred algo.UnaryPredicate[T]) bool {
+	return algo.AnyOf(Begin[T](l), End[T](l), pred)
+}
+
+// NoneOf checks if unary predicate pred returns true for no elements.
+func NoneOf[T any](l *list.List, pred algo.UnaryPredicate[T]) bool {
+	return algo.NoneOf(Begin[T](l), End[T](l), pred)
+}
+
+// Count counts the elements that are equal to value.
+func Count[T comparable](l *list.List, v T) int {
+	return algo.Count(Begin[T](l), End[T](l), v)
+}
+
+// CountIf counts elements for which predicate pred returns true.
+func CountIf[T any](l *list.List, pred algo.UnaryPredicate[T]) int {
+	return algo.CountIf(Begin[T](l), End[T](l), pred)
+}
+
+// Find returns the first element in the list that is equal to value, or nil if
+// there is no such element.
+func Find[T comparable](l *list.List, v T) *list.Element {
+	return algo.Find(Begin[T](l), End[T](l), v).e
+}
+
+// FindIf returns the first element in the list which predicate pred returns
+// true, or nil if there is no such element.
+func FindIf[T any](l *list.List, pred algo.UnaryPredicate[T]) *list.Element {
+	return algo.FindIf(Begin[T](l), End[T](l), pred).e
+}
+
+// FindIfNot returns the first element in the list which predicate pred returns
+// false, or nil if there is no such element.
+func FindIfNot[T any](l *list.List, pred algo.UnaryPredicate[T]) *list.Element {
+	return algo.FindIfNot(Begin[T](l), End[T](l), pred).e
+}
+
+// Equal returns true if two lists are equal.
+func Equal[T comparable](l1, l2 *list.List) bool {
+	end := End[T](l2)
+	return algo.Equal[T](Begin[T](l1), End[T](l1), Begin[T](l2), &end)
+}
+
+// EqualBy returns true if two lists are equal.
+//
+// Elements are compared using the given binary comparer eq.
+func EqualBy[T1, T2 any](l1, l2 *list.List, eq algo.EqComparer[T1, T2]) bool {
+	end := End[T2](l2)
+	return algo.EqualBy(Begin[T1](l1), End[T1](l1), Begin[T2](l2), &end, eq)
+}
+
+// Remove unlinks all elements equal to v from the list and returns the number
+// of removed elements.
+func Remove[T comparable](l *list.List, v T) int {
+	return RemoveIf(l, func(x T) bool { return x == v })
+}
+
+// RemoveIf unlinks all elements for which predicate pred returns true from the
+// list and returns the number of removed elements.
+func RemoveIf[T any](l *list.List, pred algo.UnaryPredicate[T]) int {
+	var removed int
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		if pred(e.Value.(T)) {
+			l.Remove(e)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
+
+// Unique unlinks all but the first element from every consecutive group of
+// equal elements from the list and returns the number of removed elements.
+// The list is either a *list.List or a *List[T]; T cannot be inferred, so it
+// is called as Unique[T](l).
+func Unique[T comparable, L *list.List | *List[T]](l L) int {
+	eq := func(x, y T) bool { return x == y }
+	if l, ok := any(l).(*List[T]); ok {
+		return l.UniqueIf(eq)
+	}
+	return UniqueIf(any(l).(*list.List), eq)
+}
+
+// UniqueIf unlinks all but the first element from every consecutive group of
+// equivalent elements from the list and returns the number of removed
+// elements.
+//
+// Elements are compared using the given binary comparer eq.
+func UniqueIf[T any](l *list.List, eq algo.EqComparer[T, T]) int {
+	var removed int
+	for e := l.Front(); e != nil && e.Next() != nil; {
+		if next := e.Next(); eq(e.Value.(T), next.Value.(T)) {
+			l.Remove(next)
+			removed++
+		} else {
+			e = next
+		}
+	}
+	return removed
+}
+
+// Reverse reverses the order of the elements in the list by relinking them.
+func Reverse(l *list.List) {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		l.MoveToFront(e)
+		e = next
+	}
+}
+
+// MaxElement returns the largest element in the list, or nil if the list is
+// empty.
+func MaxElement[T iter.Ordered](l *list.List) *list.Element {
+	return algo.MaxElement[T](Begin[T](l), End[T](l)).e
+}
+
+// MaxElementBy returns the largest element in the list, or nil if the list is
+// empty.
+//
+// Values are compared using the given binary comparer less.
+func MaxElementBy[T any](l *list.List, less algo.LessComparer[T]) *list.Element {
+	return algo.MaxElementBy(Begin[T](l), End[T](l), less).e
+}
+
+// MinElement returns the smallest element in the list, or nil if the list is
+// empty.
+func MinElement[T iter.Ordered](l *list.List) *list.Element {
+	return algo.MinElement[T](Begin[T](l), End[T](l)).e
+}
+
+// MinElementBy returns the smallest element in the list, or nil if the list is
+// empty.
+//
+// Values are compared using the given binary comparer less.
+func MinElementBy[T any](l *list.List, less algo.LessComparer[T]) *list.Element {
+	return algo.MinElementBy(Begin[T](l), End[T](l), less).e
+}
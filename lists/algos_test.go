@@ -0,0 +1,78 @@
+package lists_test
+
+import (
+	"container/list"
+	"strconv"
+	"testing"
+
+	. "github.com/disksing/iter/v2/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+func newList[T any](v ...T) *list.List {
+	l := list.New()
+	for _, x := range v {
+		l.PushBack(x)
+	}
+	return l
+}
+
+func TestListFacade(t *testing.T) {
+	assert := assert.New(t)
+	l := newList(3, 1, 4, 1, 5, 9, 2, 6)
+	even := func(x int) bool { return x%2 == 0 }
+
+	assert.False(AllOf(l, even))
+	assert.True(AnyOf(l, even))
+	assert.False(NoneOf(l, even))
+	assert.Equal(2, Count(l, 1))
+	assert.Equal(3, CountIf(l, even))
+
+	assert.Equal(l.Front().Next(), Find(l, 1))
+	assert.Nil(Find(l, 7))
+	assert.Equal(4, FindIf(l, even).Value)
+	assert.Nil(FindIf(l, func(x int) bool { return x > 10 }))
+	assert.Equal(4, FindIfNot(l, func(x int) bool { return x%2 == 1 }).Value)
+
+	assert.Equal(9, MaxElement[int](l).Value)
+	assert.Equal(1, MinElement[int](l).Value)
+	assert.Equal(l.Front().Next(), MinElement[int](l))
+	assert.Equal(1, MaxElementBy(l, func(x, y int) bool { return x > y }).Value)
+	assert.Equal(9, MinElementBy(l, func(x, y int) bool { return x > y }).Value)
+	assert.Nil(MaxElement[int](list.New()))
+	assert.Nil(MinElement[int](list.New()))
+
+	assert.True(Equal[int](l, newList(3, 1, 4, 1, 5, 9, 2, 6)))
+	assert.False(Equal[int](l, newList(3, 1, 4)))
+	assert.True(EqualBy(newList(1, 2), newList("1", "2"), func(x int, y string) bool {
+		return strconv.Itoa(x) == y
+	}))
+}
+
+func TestListFacadeModify(t *testing.T) {
+	assert := assert.New(t)
+	l := newList(1, 1, 2, 3, 3, 3, 1, 4)
+	front := l.Front()
+
+	assert.Equal(3, Unique[int](l))
+	listEq(assert, l, 1, 2, 3, 1, 4)
+	assert.Equal(front, l.Front())
+
+	assert.Equal(2, Remove(l, 1))
+	listEq(assert, l, 2, 3, 4)
+	assert.Equal(3, l.Len())
+
+	assert.Equal(2, RemoveIf(l, func(x int) bool { return x != 3 }))
+	listEq(assert, l, 3)
+
+	l = newList(1, 2, 3, 4)
+	back := l.Back()
+	Reverse(l)
+	listEq(assert, l, 4, 3, 2, 1)
+	assert.Equal(back, l.Front())
+	Reverse(list.New())
+
+	l = newList("a", "A", "b", "B", "b")
+	assert.Equal(3, UniqueIf(l, func(x, y string) bool { return x[0]|0x20 == y[0]|0x20 }))
+	listEq(assert, l, "a", "b")
+}
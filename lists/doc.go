@@ -1,5 +1,12 @@
-// Package lists adapts container/list values to the generic iterator model.
+// Package lists adapts container/list values to the generic iterator model,
+// and provides List, a typed doubly linked list.
 //
 // A container/list stores values as any. Reading an element through
-// Iterator[T] therefore panics when the stored value is not a T.
+// Iterator[T] therefore panics when the stored value is not a T. List[T]
+// stores values of type T directly and avoids both the type assertion and the
+// boxing.
+//
+// Functions such as Find, Remove, and Unique are shortcuts of the algorithms
+// for a whole *list.List. Unlike their slices counterparts, the removing ones
+// unlink elements instead of moving values.
 package lists
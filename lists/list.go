@@ -0,0 +1,373 @@
+package lists
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+type node[T any] struct {
+	next, prev *node[T]
+	value      T
+}
+
+// List is a doubly linked list whose nodes store values of type T directly,
+// like C++ std::list. The zero value is an empty list ready to use.
+//
+// SortBy, MergeBy, UniqueIf, Reverse, and Splice relink nodes instead of copying
+// values, so iterators to the moved elements stay valid. Sort, Merge, and
+// Unique are their shortcuts for ordered or comparable elements.
+type List[T any] struct {
+	root node[T]
+	len  int // -1 if unknown after a Splice
+}
+
+// NewList returns a list of the given values.
+func NewList[T any](values ...T) *List[T] {
+	l := new(List[T])
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next, l.root.prev = &l.root, &l.root
+		l.len = 0
+	}
+}
+
+// Len returns the number of elements. It takes O(n) time after a Splice of a
+// partial range between two lists, and O(1) time otherwise.
+func (l *List[T]) Len() int {
+	l.lazyInit()
+	if l.len < 0 {
+		l.len = 0
+		for n := l.root.next; n != &l.root; n = n.next {
+			l.len++
+		}
+	}
+	return l.len
+}
+
+// Begin returns an iterator to the front element of the list.
+func (l *List[T]) Begin() ListIterator[T] {
+	l.lazyInit()
+	return ListIterator[T]{n: l.root.next}
+}
+
+// End returns an iterator to the passed last element of the list.
+func (l *List[T]) End() ListIterator[T] {
+	l.lazyInit()
+	return ListIterator[T]{n: &l.root}
+}
+
+// RBegin returns an iterator to the back element of the list.
+func (l *List[T]) RBegin() ListIterator[T] {
+	l.lazyInit()
+	return ListIterator[T]{n: l.root.prev, backward: true}
+}
+
+// REnd returns an iterator to the passed first element of the list.
+func (l *List[T]) REnd() ListIterator[T] {
+	l.lazyInit()
+	return ListIterator[T]{n: &l.root, backward: true}
+}
+
+func (l *List[T]) addLen(n int) {
+	if l.len >= 0 {
+		l.len += n
+	}
+}
+
+// link inserts n before at.
+func (l *List[T]) link(n, at *node[T]) {
+	n.prev, n.next = at.prev, at
+	at.prev.next, at.prev = n, n
+	l.addLen(1)
+}
+
+func (l *List[T]) unlink(n *node[T]) {
+	n.prev.next, n.next.prev = n.next, n.prev
+	n.next, n.prev = nil, nil
+	l.addLen(-1)
+}
+
+func (l *List[T]) checkNotEmpty() {
+	l.lazyInit()
+	if l.root.next == &l.root {
+		panic("lists: empty list")
+	}
+}
+
+// PushBack inserts v at the back of the list and returns an iterator to it.
+func (l *List[T]) PushBack(v T) ListIterator[T] {
+	return l.Insert(l.End(), v)
+}
+
+// PushFront inserts v at the front of the list and returns an iterator to it.
+func (l *List[T]) PushFront(v T) ListIterator[T] {
+	return l.Insert(l.Begin(), v)
+}
+
+// PopBack removes the back element of the list and returns it. It panics if
+// the list is empty.
+func (l *List[T]) PopBack() T {
+	l.checkNotEmpty()
+	v := l.root.prev.value
+	l.Erase(l.RBegin())
+	return v
+}
+
+// PopFront removes the front element of the list and returns it. It panics if
+// the list is empty.
+func (l *List[T]) PopFront() T {
+	l.checkNotEmpty()
+	v := l.root.next.value
+	l.Erase(l.Begin())
+	return v
+}
+
+// Insert inserts v before pos in list order and returns an iterator to it,
+// moving in the same direction as pos.
+func (l *List[T]) Insert(pos ListIterator[T], v T) ListIterator[T] {
+	l.lazyInit()
+	n := &node[T]{value: v}
+	l.link(n, pos.n)
+	return ListIterator[T]{n: n, backward: pos.backward}
+}
+
+// InsertAfter inserts v after pos in list order and returns an iterator to it,
+// moving in the same direction as pos.
+func (l *List[T]) InsertAfter(pos ListIterator[T], v T) ListIterator[T] {
+	l.lazyInit()
+	n := &node[T]{value: v}
+	l.link(n, pos.n.next)
+	return ListIterator[T]{n: n, backward: pos.backward}
+}
+
+// Erase removes the element at pos and returns the iterator following it. It
+// panics if pos is an end iterator of the list.
+func (l *List[T]) Erase(pos ListIterator[T]) ListIterator[T] {
+	if pos.n == &l.root {
+		panic("lists: Erase of the end iterator")
+	}
+	next := pos.Next()
+	l.unlink(pos.n)
+	return next
+}
+
+// EraseRange removes the elements in the range [first, last) and returns
+// last.
+func (l *List[T]) EraseRange(first, last ListIterator[T]) ListIterator[T] {
+	for !first.Eq(last) {
+		first = l.Erase(first)
+	}
+	return last
+}
+
+// Splice moves the elements in the range [first, last) of other before pos in
+// l, without copying. other may be l itself, in which case pos must not be in
+// (first, last). first and last must be forward iterators.
+//
+// Splice takes O(1) time. If a partial range is moved between two lists, the
+// lengths of both lists are recounted by their next Len calls.
+func (l *List[T]) Splice(pos ListIterator[T], other *List[T], first, last ListIterator[T]) {
+	if first.backward || last.backward {
+		panic("lists: Splice requires forward iterators")
+	}
+	if first.Eq(last) || pos.n == first.n || pos.n == last.n {
+		return
+	}
+	l.lazyInit()
+	if other != l {
+		switch {
+		case first.n == other.root.next && last.n == &other.root:
+			l.addLen(other.Len())
+			other.len = 0
+		case first.n.next == last.n:
+			l.addLen(1)
+			other.addLen(-1)
+		default:
+			l.len, other.len = -1, -1
+		}
+	}
+	head, tail := first.n, last.n.prev
+	// Detach [head, tail] from other.
+	head.prev.next, last.n.prev = last.n, head.prev
+	// Attach it before pos.
+	head.prev, tail.next = pos.n.prev, pos.n
+	pos.n.prev.next, pos.n.prev = head, tail
+}
+
+// Reverse reverses the order of the elements in the list.
+func (l *List[T]) Reverse() {
+	l.lazyInit()
+	n := &l.root
+	for {
+		n.next, n.prev = n.prev, n.next
+		if n = n.prev; n == &l.root {
+			return
+		}
+	}
+}
+
+// UniqueIf removes all but the first element from every consecutive group of
+// equivalent elements and returns the number of removed elements.
+//
+// Elements are compared using the given binary comparer eq.
+func (l *List[T]) UniqueIf(eq algo.EqComparer[T, T]) int {
+	l.lazyInit()
+	var removed int
+	for n := l.root.next; n != &l.root && n.next != &l.root; {
+		if eq(n.value, n.next.value) {
+			l.unlink(n.next)
+			removed++
+		} else {
+			n = n.next
+		}
+	}
+	return removed
+}
+
+// detach removes all nodes from the list and returns them as a nil-terminated
+// chain linked by next.
+func (l *List[T]) detach() (*node[T], int) {
+	n := l.Len()
+	if n == 0 {
+		return nil, 0
+	}
+	head := l.root.next
+	l.root.prev.next = nil
+	l.root.next, l.root.prev, l.len = &l.root, &l.root, 0
+	return head, n
+}
+
+// attach appends a nil-terminated chain of n nodes to the empty list.
+func (l *List[T]) attach(head *node[T], n int) {
+	prev := &l.root
+	for ; head != nil; head = head.next {
+		head.prev, prev.next = prev, head
+		prev = head
+	}
+	prev.next, l.root.prev = &l.root, prev
+	l.len = n
+}
+
+func mergeChain[T any](a, b *node[T], less algo.LessComparer[T]) *node[T] {
+	var head node[T]
+	tail := &head
+	for a != nil && b != nil {
+		if less(b.value, a.value) {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	return head.next
+}
+
+func sortChain[T any](head *node[T], n int, less algo.LessComparer[T]) *node[T] {
+	if n <= 1 {
+		return head
+	}
+	m := head
+	for i := 1; i < n/2; i++ {
+		m = m.next
+	}
+	rest := m.next
+	m.next = nil
+	return mergeChain(sortChain(head, n/2, less), sortChain(rest, n-n/2, less), less)
+}
+
+// SortBy sorts the list stably by relinking nodes, in O(n log n) time.
+//
+// Elements are compared using the given binary comparer less.
+func (l *List[T]) SortBy(less algo.LessComparer[T]) {
+	l.lazyInit()
+	head, n := l.detach()
+	l.attach(sortChain(head, n, less), n)
+}
+
+// MergeBy merges the sorted list other into the sorted list l by relinking
+// nodes, leaving other empty. For equivalent elements, the ones from l precede
+// the ones from other.
+//
+// Elements are compared using the given binary comparer less.
+func (l *List[T]) MergeBy(other *List[T], less algo.LessComparer[T]) {
+	if other == l {
+		return
+	}
+	l.lazyInit()
+	other.lazyInit()
+	a, n1 := l.detach()
+	b, n2 := other.detach()
+	l.attach(mergeChain(a, b, less), n1+n2)
+}
+
+// Sort sorts the list stably in ascending order by relinking nodes, in
+// O(n log n) time.
+func Sort[T iter.Ordered](l *List[T]) {
+	l.SortBy(func(x, y T) bool { return x < y })
+}
+
+// Merge merges the sorted list other into the sorted list l by relinking
+// nodes, leaving other empty. For equal elements, the ones from l precede the
+// ones from other.
+func Merge[T iter.Ordered](l, other *List[T]) {
+	l.MergeBy(other, func(x, y T) bool { return x < y })
+}
+
+// ListIterator is a bidirectional iterator over a List.
+type ListIterator[T any] struct {
+	n        *node[T]
+	backward bool
+}
+
+func (it ListIterator[T]) Eq(x ListIterator[T]) bool {
+	return it.n == x.n
+}
+
+func (it ListIterator[T]) AllowMultiplePass() {}
+
+func (it ListIterator[T]) Next() ListIterator[T] {
+	if it.backward {
+		return ListIterator[T]{n: it.n.prev, backward: true}
+	}
+	return ListIterator[T]{n: it.n.next}
+}
+
+func (it ListIterator[T]) Prev() ListIterator[T] {
+	if it.backward {
+		return ListIterator[T]{n: it.n.next, backward: true}
+	}
+	return ListIterator[T]{n: it.n.prev}
+}
+
+func (it ListIterator[T]) Read() T {
+	return it.n.value
+}
+
+func (it ListIterator[T]) Write(v T) {
+	it.n.value = v
+}
+
+// ListAppender is an output iterator that appends values to a List.
+type ListAppender[T any] struct {
+	l *List[T]
+}
+
+// Appender returns an OutputIter to append elements to the back of the List.
+func Appender[T any](l *List[T]) ListAppender[T] {
+	return ListAppender[T]{l: l}
+}
+
+func (la ListAppender[T]) Write(v T) {
+	la.l.PushBack(v)
+}
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/disksing/iter/v2"
-	. "github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/algo"
 	. "github.com/disksing/iter/v2/lists"
 	"github.com/disksing/iter/v2/slices"
 	"github.com/stretchr/testify/assert"
@@ -15,14 +15,14 @@ var _ iter.BidiReadWriter[int, Iterator[int]] = Iterator[int]{}
 
 func listEq[T comparable](assert *assert.Assertions, lst *list.List, v ...T) {
 	end := slices.End(v)
-	assert.True(Equal[T](Begin[T](lst), End[T](lst), slices.Begin(v), &end))
+	assert.True(algo.Equal[T](Begin[T](lst), End[T](lst), slices.Begin(v), &end))
 }
 
 func TestListIterator(t *testing.T) {
 	assert := assert.New(t)
 	lst := list.New()
 	listEq[int](assert, lst)
-	GenerateN(ListBackInserter[int](lst), 3, iter.IotaGenerator(1))
+	algo.GenerateN(ListBackInserter[int](lst), 3, iter.IotaGenerator(1))
 	listEq(assert, lst, 1, 2, 3)
 
 	b := Begin[int](lst)
@@ -0,0 +1,195 @@
+package lists_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/lists"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.BidiReadWriter[int, ListIterator[int]] = ListIterator[int]{}
+	_ iter.OutputIter[int]                        = ListAppender[int]{}
+)
+
+// values returns the list elements, and checks that the backward links match
+// the forward ones.
+func values[T comparable](l *List[T]) []T {
+	ret := []T{}
+	algo.Copy[T](l.Begin(), l.End(), slices.Appender(&ret))
+	var rev []T
+	algo.Copy[T](l.RBegin(), l.REnd(), slices.Appender(&rev))
+	slices.Reverse(rev)
+	if len(rev) != len(ret) || !slices.Equal(ret, rev) {
+		panic("broken links")
+	}
+	return ret
+}
+
+func TestList(t *testing.T) {
+	assert := assert.New(t)
+	var l List[int]
+	assert.Equal(0, l.Len())
+	assert.True(l.Begin().Eq(l.End()))
+
+	l.PushBack(2)
+	l.PushFront(1)
+	it := l.PushBack(4)
+	l.Insert(it, 3)
+	l.InsertAfter(it, 5)
+	assert.Equal([]int{1, 2, 3, 4, 5}, values(&l))
+	assert.Equal(5, l.Len())
+
+	it.AllowMultiplePass()
+	assert.Equal(3, it.Prev().Read())
+	it.Write(40)
+	assert.Equal(5, l.Erase(it).Read())
+	assert.Equal([]int{1, 2, 3, 5}, values(&l))
+
+	rit := l.RBegin().Next()
+	assert.Equal(3, rit.Read())
+	assert.Equal(5, rit.Prev().Read())
+	assert.Equal(2, l.Erase(rit).Read())
+	l.InsertAfter(l.RBegin(), 6)
+	assert.Equal([]int{1, 2, 5, 6}, values(&l))
+
+	assert.Equal(6, l.PopBack())
+	assert.Equal(1, l.PopFront())
+	assert.Equal([]int{2, 5}, values(&l))
+	assert.True(l.EraseRange(l.Begin(), l.End()).Eq(l.End()))
+	assert.Equal(0, l.Len())
+
+	algo.CopyN[int](iter.IotaReader(1), 3, Appender(&l))
+	assert.Equal([]int{1, 2, 3}, values(&l))
+	assert.Equal([]int{1, 2, 3}, values(NewList(1, 2, 3)))
+}
+
+func TestListEmpty(t *testing.T) {
+	assert := assert.New(t)
+	var zero List[int]
+	assert.PanicsWithValue("lists: empty list", func() { zero.PopFront() })
+	assert.PanicsWithValue("lists: empty list", func() { zero.PopBack() })
+
+	l := NewList(1)
+	assert.Equal(1, l.PopBack())
+	assert.PanicsWithValue("lists: empty list", func() { l.PopBack() })
+	assert.PanicsWithValue("lists: empty list", func() { l.PopFront() })
+
+	l = NewList(1, 2)
+	assert.PanicsWithValue("lists: Erase of the end iterator", func() { l.Erase(l.End()) })
+	assert.PanicsWithValue("lists: Erase of the end iterator", func() { l.Erase(l.REnd()) })
+	assert.Equal([]int{1, 2}, values(l))
+	assert.Equal(2, l.Len())
+}
+
+func TestListSplice(t *testing.T) {
+	assert := assert.New(t)
+	a, b := NewList(1, 2, 3), NewList(10, 20, 30, 40)
+
+	// single element
+	a.Splice(a.Begin().Next(), b, b.Begin(), b.Begin().Next())
+	assert.Equal([]int{1, 10, 2, 3}, values(a))
+	assert.Equal([]int{20, 30, 40}, values(b))
+	assert.Equal(4, a.Len())
+	assert.Equal(3, b.Len())
+
+	// partial range
+	a.Splice(a.End(), b, b.Begin(), b.End().Prev())
+	assert.Equal([]int{1, 10, 2, 3, 20, 30}, values(a))
+	assert.Equal([]int{40}, values(b))
+	assert.Equal(6, a.Len())
+	assert.Equal(1, b.Len())
+
+	// whole list
+	a.Splice(a.Begin(), b, b.Begin(), b.End())
+	assert.Equal([]int{40, 1, 10, 2, 3, 20, 30}, values(a))
+	assert.Equal([]int{}, values(b))
+	assert.Equal(7, a.Len())
+	assert.Equal(0, b.Len())
+
+	// same list
+	last := a.End().Prev()
+	a.Splice(a.Begin(), a, last.Prev(), a.End())
+	assert.Equal([]int{20, 30, 40, 1, 10, 2, 3}, values(a))
+	a.Splice(last, a, last, a.End())
+	a.Splice(a.Begin(), a, a.Begin(), a.Begin())
+	assert.Equal([]int{20, 30, 40, 1, 10, 2, 3}, values(a))
+	assert.Equal(7, a.Len())
+
+	// iterators stay valid
+	assert.Equal(30, last.Read())
+	assert.Equal(40, last.Next().Read())
+
+	assert.Panics(func() { a.Splice(a.Begin(), b, a.RBegin(), a.REnd()) })
+}
+
+type item struct{ k, i int }
+
+func TestListSortMerge(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	less := func(x, y item) bool { return x.k < y.k }
+	for n := 0; n < 50; n++ {
+		var s []item
+		for i := 0; i < n; i++ {
+			s = append(s, item{r.Intn(5), i})
+		}
+		l := NewList(s...)
+		l.SortBy(less)
+		sort.SliceStable(s, func(i, j int) bool { return s[i].k < s[j].k })
+		assert.Equal(append([]item{}, s...), values(l))
+		assert.Equal(n, l.Len())
+	}
+
+	a := NewList(item{1, 0}, item{3, 0}, item{3, 1}, item{5, 0})
+	b := NewList(item{0, 2}, item{3, 2}, item{6, 2})
+	first := a.Begin()
+	a.MergeBy(b, less)
+	assert.Equal([]item{{0, 2}, {1, 0}, {3, 0}, {3, 1}, {3, 2}, {5, 0}, {6, 2}}, values(a))
+	assert.Equal(0, b.Len())
+	assert.Equal(7, a.Len())
+	assert.Equal(item{1, 0}, first.Read())
+	a.MergeBy(a, less)
+	assert.Equal(7, a.Len())
+
+	c, d := NewList(5, 1, 3, 1), NewList(4, 2, 0)
+	Sort(c)
+	Sort(d)
+	assert.Equal([]int{1, 1, 3, 5}, values(c))
+	Merge(c, d)
+	assert.Equal([]int{0, 1, 1, 2, 3, 4, 5}, values(c))
+	assert.Equal(0, d.Len())
+	var empty List[string]
+	Sort(&empty)
+	Merge(&empty, NewList("b", "a"))
+	assert.Equal([]string{"b", "a"}, values(&empty))
+}
+
+func TestListUniqueReverse(t *testing.T) {
+	assert := assert.New(t)
+	l := NewList(1, 1, 2, 3, 3, 3, 1)
+	assert.Equal(3, l.UniqueIf(func(x, y int) bool { return x == y }))
+	assert.Equal([]int{1, 2, 3, 1}, values(l))
+	assert.Equal(4, l.Len())
+
+	l.PushBack(1)
+	assert.Equal(1, Unique[int](l))
+	assert.Equal([]int{1, 2, 3, 1}, values(l))
+
+	l.Reverse()
+	assert.Equal([]int{1, 3, 2, 1}, values(l))
+	l.PushBack(0)
+	assert.Equal([]int{1, 3, 2, 1, 0}, values(l))
+
+	var empty List[int]
+	empty.Reverse()
+	assert.Equal(0, empty.UniqueIf(func(x, y int) bool { return x == y }))
+	assert.Equal(0, Unique[int](&empty))
+	empty.SortBy(func(x, y int) bool { return x < y })
+	assert.Equal(0, empty.Len())
+}
@@ -0,0 +1,122 @@
+package par
+
+import (
+	"sync/atomic"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// ForEach applies the given function f to each element in the range
+// [first, last), in no particular order.
+func ForEach[T any, It iter.RandomReader[T, It]](p Policy, first, last It, f algo.IteratorFunction[T]) {
+	n := first.Distance(last)
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		algo.ForEach(first.AdvanceN(lo), first.AdvanceN(hi), f)
+	})
+}
+
+// Transform applies the given function op to the range [first, last) and
+// stores the result in another range, beginning at dFirst. It returns the
+// output iterator passed the last written element.
+func Transform[T1, T2 any, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, op algo.UnaryOperation[T1, T2]) Out {
+	n := first.Distance(last)
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		algo.Transform(first.AdvanceN(lo), first.AdvanceN(hi), dFirst.AdvanceN(lo), op)
+	})
+	return dFirst.AdvanceN(n)
+}
+
+// TransformBinary applies the given function op to the pairs of elements in
+// the range [first1, last1) and the range beginning at first2, and stores the
+// result in another range, beginning at dFirst. It returns the output iterator
+// passed the last written element.
+func TransformBinary[T1, T2, T3 any, In1 iter.RandomReader[T1, In1], In2 iter.RandomReader[T2, In2], Out iter.RandomWriter[T3, Out]](p Policy, first1, last1 In1, first2 In2, dFirst Out, op algo.BinaryOperation[T1, T2, T3]) Out {
+	n := first1.Distance(last1)
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		algo.TransformBinary(first1.AdvanceN(lo), first1.AdvanceN(hi), first2.AdvanceN(lo), dFirst.AdvanceN(lo), op)
+	})
+	return dFirst.AdvanceN(n)
+}
+
+// Reduce returns the sum of v and the elements in the range [first, last).
+func Reduce[T iter.Numeric, It iter.RandomReader[T, It]](p Policy, first, last It, v T) T {
+	return ReduceBy(p, first, last, v, add[T])
+}
+
+// ReduceBy folds the elements in the range [first, last) and v with the given
+// binary function op. op must be associative. Elements keep their relative
+// order, so unlike for algo.ReduceBy, op need not be commutative, but it is
+// applied in an unspecified grouping.
+func ReduceBy[T any, It iter.RandomReader[T, It]](p Policy, first, last It, v T, op algo.BinaryOperation[T, T, T]) T {
+	return TransformReduceBy(p, first, last, v, op, noop[T])
+}
+
+// TransformReduce applies op to each element in the range [first, last), and
+// returns the sum of v and the results.
+func TransformReduce[T1 any, T2 iter.Numeric, It iter.RandomReader[T1, It]](p Policy, first, last It, v T2, op algo.UnaryOperation[T1, T2]) T2 {
+	return TransformReduceBy(p, first, last, v, add[T2], op)
+}
+
+// TransformReduceBy applies transform to each element in the range
+// [first, last), and folds the results and v with reduce. reduce must be
+// associative. Results keep the relative order of their elements, so reduce
+// need not be commutative, but it is applied in an unspecified grouping.
+func TransformReduceBy[T1, T2 any, It iter.RandomReader[T1, It]](p Policy, first, last It, v T2, reduce algo.BinaryOperation[T2, T2, T2], transform algo.UnaryOperation[T1, T2]) T2 {
+	n := first.Distance(last)
+	if n == 0 {
+		return v
+	}
+	k := p.chunks(n)
+	partial := make([]T2, k)
+	run(k, n, func(c, lo, hi int) {
+		it := first.AdvanceN(lo)
+		partial[c] = algo.AccumulateBy(it.Next(), first.AdvanceN(hi), transform(it.Read()), func(acc T2, x T1) T2 {
+			return reduce(acc, transform(x))
+		})
+	})
+	for _, x := range partial {
+		v = reduce(v, x)
+	}
+	return v
+}
+
+// CountIf counts elements for which predicate pred returns true.
+func CountIf[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) int {
+	return TransformReduce(p, first, last, 0, func(x T) int {
+		if pred(x) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// AllOf checks if unary predicate pred returns true for all elements in the
+// range [first, last). Once an element is found for which pred returns false,
+// the remaining elements are not checked.
+func AllOf[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) bool {
+	return !AnyOf(p, first, last, func(x T) bool { return !pred(x) })
+}
+
+// AnyOf checks if unary predicate pred returns true for at least one element
+// in the range [first, last). Once such element is found, the remaining
+// elements are not checked.
+func AnyOf[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) bool {
+	var found atomic.Bool
+	n := first.Distance(last)
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		for it, end := first.AdvanceN(lo), first.AdvanceN(hi); !it.Eq(end) && !found.Load(); it = it.Next() {
+			if pred(it.Read()) {
+				found.Store(true)
+			}
+		}
+	})
+	return found.Load()
+}
+
+// NoneOf checks if unary predicate pred returns true for no elements in the
+// range [first, last). Once an element is found for which pred returns true,
+// the remaining elements are not checked.
+func NoneOf[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) bool {
+	return !AnyOf(p, first, last, pred)
+}
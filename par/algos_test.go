@@ -0,0 +1,134 @@
+package par_test
+
+import (
+	"math/rand"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/par"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var policies = []Policy{
+	Default,
+	{Workers: 1},
+	{Workers: 4, Grain: 1},
+	{Workers: 3, Grain: 7},
+	{Workers: 64, Grain: 100},
+}
+
+func randInts(n int) []int {
+	r := rand.New(rand.NewSource(int64(n)))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(1000)
+	}
+	return s
+}
+
+func TestForEachTransform(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 10, 1000, 10000} {
+			a := randInts(n)
+			var sum atomic.Int64
+			ForEach(p, slices.Begin(a), slices.End(a), func(x int) { sum.Add(int64(x)) })
+			assert.Equal(int64(algo.Accumulate(slices.Begin(a), slices.End(a), 0)), sum.Load())
+
+			b := make([]string, n)
+			end := Transform(p, slices.Begin(a), slices.End(a), slices.Begin(b), strconv.Itoa)
+			assert.True(end.Eq(slices.End(b)))
+			var want []string
+			algo.Transform(slices.Begin(a), slices.End(a), slices.Appender(&want), strconv.Itoa)
+			assert.Equal(append([]string{}, want...), b)
+
+			c := make([]int, n)
+			TransformBinary(p, slices.Begin(a), slices.End(a), slices.RBegin(a), slices.Begin(c), func(x, y int) int { return x - y })
+			for i := range c {
+				assert.Equal(a[i]-a[n-1-i], c[i])
+			}
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 10, 1000, 10000} {
+			a := randInts(n)
+			first, last := slices.Begin(a), slices.End(a)
+			assert.Equal(algo.Accumulate(first, last, 5), Reduce(p, first, last, 5))
+
+			// String concatenation is associative but not commutative.
+			s := make([]string, n)
+			algo.Transform(first, last, slices.Begin(s), strconv.Itoa)
+			concat := func(x, y string) string { return x + "," + y }
+			want := algo.AccumulateBy(slices.Begin(s), slices.End(s), "", concat)
+			assert.Equal(want, ReduceBy(p, slices.Begin(s), slices.End(s), "", concat))
+			assert.Equal(want, TransformReduceBy(p, first, last, "", concat, strconv.Itoa))
+
+			square := func(x int) int { return x * x }
+			want2 := algo.AccumulateBy(first, last, 0, func(acc, x int) int { return acc + square(x) })
+			assert.Equal(want2, TransformReduce(p, first, last, 0, square))
+		}
+	}
+}
+
+func TestCountAllOf(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		a := randInts(5000)
+		even := func(x int) bool { return x%2 == 0 }
+		small := func(x int) bool { return x < 1000 }
+		first, last := slices.Begin(a), slices.End(a)
+		assert.Equal(algo.CountIf(first, last, even), CountIf(p, first, last, even))
+		assert.True(AllOf(p, first, last, small))
+		assert.False(AllOf(p, first, last, even))
+		assert.True(AllOf(p, first, first, even))
+
+		var calls atomic.Int64
+		a[0] = -1
+		AllOf(p, first, last, func(x int) bool {
+			calls.Add(1)
+			return x >= 0
+		})
+		if p.Workers == 1 {
+			assert.Equal(int64(1), calls.Load())
+		}
+	}
+}
+
+func TestGrain(t *testing.T) {
+	assert := assert.New(t)
+	a := randInts(1000)
+	// A range shorter than twice the grain is processed in order by the
+	// calling goroutine.
+	var got []int
+	ForEach(Policy{Workers: 8, Grain: 501}, slices.Begin(a), slices.End(a), func(x int) { got = append(got, x) })
+	assert.Equal(a, got)
+}
+
+func TestPanic(t *testing.T) {
+	assert := assert.New(t)
+	a := randInts(1000)
+	for _, p := range policies {
+		assert.PanicsWithValue("boom", func() {
+			ForEach(p, slices.Begin(a), slices.End(a), func(x int) {
+				if x == a[len(a)-1] {
+					panic("boom")
+				}
+			})
+		})
+	}
+}
+
+func TestReduceWithoutIdentity(t *testing.T) {
+	assert := assert.New(t)
+	a := randInts(3000)
+	first, last := slices.Begin(a), slices.End(a)
+	maxOp := func(x, y int) int { return max(x, y) }
+	assert.Equal(algo.MaxElement[int](first, last).Read(), ReduceBy(Policy{Workers: 4, Grain: 10}, first, last, -1, maxOp))
+}
@@ -0,0 +1,20 @@
+// Package par provides parallel versions of algorithms over random-access
+// ranges.
+//
+// Each function takes a Policy, which sets the number of goroutines and the
+// smallest number of elements worth a goroutine. A range is split into
+// contiguous chunks that are processed concurrently, one of them by the
+// calling goroutine. If a function given to an algorithm panics, the panic is
+// propagated to the caller after all goroutines have returned.
+//
+// Reductions and scans combine the results of the chunks in order, so their
+// binary operations must be associative, but need not be commutative. For
+// such operations, the results are the same as the ones of the sequential
+// algorithms in algo; floating-point addition is only approximately
+// associative, so its results may differ in rounding.
+//
+// Functions given to the algorithms may be called concurrently, and output
+// iterators may be written concurrently at distinct positions, which is safe
+// for slices.Iterator but not for iterators that share state between
+// positions, such as bitset.Iterator.
+package par
@@ -0,0 +1,76 @@
+package par
+
+import (
+	"sync/atomic"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// findFirst returns the smallest i in [0, n) for which match returns true, or
+// n if there is no such i. A goroutine stops once a match before its current
+// position has been found.
+func findFirst(p Policy, n int, match func(i int) bool) int {
+	var best atomic.Int64
+	best.Store(int64(n))
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		for i := lo; i < hi && int64(i) < best.Load(); i++ {
+			if !match(i) {
+				continue
+			}
+			for {
+				b := best.Load()
+				if int64(i) >= b || best.CompareAndSwap(b, int64(i)) {
+					return
+				}
+			}
+		}
+	})
+	return int(best.Load())
+}
+
+// Find returns the first element in the range [first, last) that is equal to
+// value.
+func Find[T comparable, It iter.RandomReader[T, It]](p Policy, first, last It, v T) It {
+	return FindIf(p, first, last, func(x T) bool { return x == v })
+}
+
+// FindIf returns the first element in the range [first, last) which predicate
+// pred returns true. Elements after a found one are not checked.
+func FindIf[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) It {
+	return first.AdvanceN(findFirst(p, first.Distance(last), func(i int) bool {
+		return pred(first.AdvanceN(i).Read())
+	}))
+}
+
+// FindIfNot returns the first element in the range [first, last) which
+// predicate pred returns false. Elements after a found one are not checked.
+func FindIfNot[T any, It iter.RandomReader[T, It]](p Policy, first, last It, pred algo.UnaryPredicate[T]) It {
+	return FindIf(p, first, last, func(x T) bool { return !pred(x) })
+}
+
+// Search searches for the first occurrence of the sequence of elements
+// [sFirst, sLast) in the range [first, last).
+func Search[T comparable, It1 iter.RandomReader[T, It1], It2 iter.ForwardReader[T, It2]](p Policy, first, last It1, sFirst, sLast It2) It1 {
+	return SearchBy(p, first, last, sFirst, sLast, func(x, y T) bool { return x == y })
+}
+
+// SearchBy searches for the first occurrence of the sequence of elements
+// [sFirst, sLast) in the range [first, last). Positions after a found
+// occurrence are not checked.
+//
+// Elements are compared using the given binary comparer eq.
+func SearchBy[T1, T2 any, It1 iter.RandomReader[T1, It1], It2 iter.ForwardReader[T2, It2]](p Policy, first, last It1, sFirst, sLast It2, eq algo.EqComparer[T1, T2]) It1 {
+	n, m := first.Distance(last), iter.Distance[T2](sFirst, sLast)
+	if m > n {
+		return last
+	}
+	i := findFirst(p, n-m+1, func(i int) bool {
+		it := first.AdvanceN(i)
+		return algo.EqualBy(it, it.AdvanceN(m), sFirst, nil, eq)
+	})
+	if i == n-m+1 {
+		return last
+	}
+	return first.AdvanceN(i)
+}
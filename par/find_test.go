@@ -0,0 +1,78 @@
+package par_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/par"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 10, 1000, 10000} {
+			a := randInts(n)
+			first, last := slices.Begin(a), slices.End(a)
+			for _, v := range []int{0, 1, 500, 999, 1000} {
+				assert.True(algo.Find(first, last, v).Eq(Find(p, first, last, v)))
+				pred := func(x int) bool { return x > v }
+				assert.True(algo.FindIf(first, last, pred).Eq(FindIf(p, first, last, pred)))
+				assert.True(algo.FindIfNot(first, last, pred).Eq(FindIfNot(p, first, last, pred)))
+				assert.Equal(algo.AnyOf(first, last, pred), AnyOf(p, first, last, pred))
+				assert.Equal(algo.NoneOf(first, last, pred), NoneOf(p, first, last, pred))
+			}
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		a := make([]int, 5000)
+		for i := range a {
+			a[i] = i % 7
+		}
+		first, last := slices.Begin(a), slices.End(a)
+		for _, s := range [][]int{{}, {3}, {5, 6, 0, 1}, {6, 6}, a[4990:], a[3:]} {
+			want := algo.Search[int](first, last, slices.Begin(s), slices.End(s))
+			assert.True(want.Eq(Search[int](p, first, last, slices.Begin(s), slices.End(s))))
+		}
+		long := make([]int, 5001)
+		assert.True(Search[int](p, first, last, slices.Begin(long), slices.End(long)).Eq(last))
+
+		eq := func(x int, y string) bool { return "0123456"[x] == y[0] }
+		pattern := []string{"4", "5"}
+		assert.Equal(4, first.Distance(SearchBy(p, first, last, slices.Begin(pattern), slices.End(pattern), eq)))
+	}
+}
+
+func TestFindCancel(t *testing.T) {
+	assert := assert.New(t)
+	a := make([]int, 4000)
+	a[0] = 1
+	var calls atomic.Int64
+	it := FindIf(Policy{Workers: 4, Grain: 1}, slices.Begin(a), slices.End(a), func(x int) bool {
+		calls.Add(1)
+		if x == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return x == 1
+	})
+	assert.Equal(0, slices.Begin(a).Distance(it))
+	assert.Less(calls.Load(), int64(100))
+
+	calls.Store(0)
+	a[0], a[3000] = 0, 1
+	assert.True(AnyOf(Policy{Workers: 4, Grain: 1}, slices.Begin(a), slices.End(a), func(x int) bool {
+		calls.Add(1)
+		if x == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return x == 1
+	}))
+	assert.Less(calls.Load(), int64(100))
+}
@@ -0,0 +1,57 @@
+package par_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/par"
+	iterslices "github.com/disksing/iter/v2/slices"
+)
+
+func FuzzSort(f *testing.F) {
+	f.Add([]byte{}, uint8(1), uint8(1))
+	f.Add([]byte{1}, uint8(4), uint8(1))
+	f.Add([]byte{3, 1, 2, 1, 0, 255}, uint8(3), uint8(2))
+
+	f.Fuzz(func(t *testing.T, input []byte, workers, grain uint8) {
+		if len(input) > 4096 {
+			t.Skip()
+		}
+		p := par.Policy{Workers: int(workers), Grain: int(grain)}
+
+		got := slices.Clone(input)
+		want := slices.Clone(input)
+		par.Sort[byte](p, iterslices.Begin(got), iterslices.End(got))
+		algo.Sort[byte](iterslices.Begin(want), iterslices.End(want))
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("Sort() = %v, want %v", got, want)
+		}
+	})
+}
+
+func FuzzStableSortBy(f *testing.F) {
+	f.Add([]byte{}, uint8(1), uint8(1))
+	f.Add([]byte{7, 3, 7, 1, 3, 3}, uint8(3), uint8(1))
+	f.Add([]byte{0, 255, 16, 17, 32, 33, 1}, uint8(8), uint8(2))
+
+	f.Fuzz(func(t *testing.T, input []byte, workers, grain uint8) {
+		if len(input) > 4096 {
+			t.Skip()
+		}
+		p := par.Policy{Workers: int(workers), Grain: int(grain)}
+		// Only the high nibble takes part in comparisons, so that the
+		// order of equal elements is visible in the low nibble.
+		less := func(x, y byte) bool { return x>>4 < y>>4 }
+
+		got := slices.Clone(input)
+		want := slices.Clone(input)
+		par.StableSortBy(p, iterslices.Begin(got), iterslices.End(got), less)
+		algo.StableSortBy(iterslices.Begin(want), iterslices.End(want), less)
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("StableSortBy() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package par
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// InclusiveScan computes an inclusive prefix sum operation using v=v+cur for
+// the range [first, last), using v as the initial value, and writes the
+// results to the range beginning at dFirst. The output range may be the input
+// range itself.
+func InclusiveScan[T iter.Numeric, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out, v T) Out {
+	return TransformInclusiveScanBy(p, first, last, dFirst, v, add[T], noop[T])
+}
+
+// InclusiveScanBy computes an inclusive prefix sum operation using
+// v=add(v,cur) for the range [first, last), using v as the initial value, and
+// writes the results to the range beginning at dFirst. add must be
+// associative. The output range may be the input range itself.
+func InclusiveScanBy[T any, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out, v T, add algo.BinaryOperation[T, T, T]) Out {
+	return TransformInclusiveScanBy(p, first, last, dFirst, v, add, noop[T])
+}
+
+// ExclusiveScan computes an exclusive prefix sum operation using v=v+cur for
+// the range [first, last), using v as the initial value, and writes the
+// results to the range beginning at dFirst. The output range may be the input
+// range itself.
+func ExclusiveScan[T iter.Numeric, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out, v T) Out {
+	return TransformExclusiveScanBy(p, first, last, dFirst, v, add[T], noop[T])
+}
+
+// ExclusiveScanBy computes an exclusive prefix sum operation using
+// v=add(v,cur) for the range [first, last), using v as the initial value, and
+// writes the results to the range beginning at dFirst. add must be
+// associative. The output range may be the input range itself.
+func ExclusiveScanBy[T any, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out, v T, add algo.BinaryOperation[T, T, T]) Out {
+	return TransformExclusiveScanBy(p, first, last, dFirst, v, add, noop[T])
+}
+
+// TransformInclusiveScan transforms each element in the range [first, last)
+// with op, then computes an inclusive prefix sum operation using v=v+cur,
+// using v as the initial value, and writes the results to the range beginning
+// at dFirst.
+func TransformInclusiveScan[T1, T2 iter.Numeric, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, v T2, op algo.UnaryOperation[T1, T2]) Out {
+	return TransformInclusiveScanBy(p, first, last, dFirst, v, add[T2], op)
+}
+
+// TransformInclusiveScanBy transforms each element in the range [first, last)
+// with op, then computes an inclusive prefix sum operation using
+// v=add(v,cur), using v as the initial value, and writes the results to the
+// range beginning at dFirst. add must be associative.
+func TransformInclusiveScanBy[T1, T2 any, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, v T2, add algo.BinaryOperation[T2, T2, T2], op algo.UnaryOperation[T1, T2]) Out {
+	return scan(p, first, last, dFirst, v, add, op, algo.TransformInclusiveScanBy[T1, T2, T2, In, Out])
+}
+
+// TransformExclusiveScan transforms each element in the range [first, last)
+// with op, then computes an exclusive prefix sum operation using v=v+cur,
+// using v as the initial value, and writes the results to the range beginning
+// at dFirst.
+func TransformExclusiveScan[T1, T2 iter.Numeric, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, v T2, op algo.UnaryOperation[T1, T2]) Out {
+	return TransformExclusiveScanBy(p, first, last, dFirst, v, add[T2], op)
+}
+
+// TransformExclusiveScanBy transforms each element in the range [first, last)
+// with op, then computes an exclusive prefix sum operation using
+// v=add(v,cur), using v as the initial value, and writes the results to the
+// range beginning at dFirst. add must be associative.
+func TransformExclusiveScanBy[T1, T2 any, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, v T2, add algo.BinaryOperation[T2, T2, T2], op algo.UnaryOperation[T1, T2]) Out {
+	return scan(p, first, last, dFirst, v, add, op, algo.TransformExclusiveScanBy[T1, T2, T2, In, Out])
+}
+
+// scan is a two-pass blocked scan. The first pass reduces each chunk, the
+// totals are scanned sequentially to get the initial value of each chunk, and
+// the second pass scans each chunk from its initial value with seq.
+func scan[T1, T2 any, In iter.RandomReader[T1, In], Out iter.RandomWriter[T2, Out]](p Policy, first, last In, dFirst Out, v T2, add algo.BinaryOperation[T2, T2, T2], op algo.UnaryOperation[T1, T2], seq func(In, In, Out, T2, algo.BinaryOperation[T2, T2, T2], algo.UnaryOperation[T1, T2]) Out) Out {
+	n := first.Distance(last)
+	k := p.chunks(n)
+	if k == 1 {
+		return seq(first, last, dFirst, v, add, op)
+	}
+	init := make([]T2, k)
+	run(k, n, func(c, lo, hi int) {
+		if c+1 == k {
+			return // the total of the last chunk is not needed
+		}
+		it := first.AdvanceN(lo)
+		init[c] = algo.AccumulateBy(it.Next(), first.AdvanceN(hi), op(it.Read()), func(acc T2, x T1) T2 {
+			return add(acc, op(x))
+		})
+	})
+	for c := range init {
+		total := init[c]
+		if init[c] = v; c+1 < k {
+			v = add(v, total)
+		}
+	}
+	run(k, n, func(c, lo, hi int) {
+		seq(first.AdvanceN(lo), first.AdvanceN(hi), dFirst.AdvanceN(lo), init[c], add, op)
+	})
+	return dFirst.AdvanceN(n)
+}
+
+// AdjacentDifference computes the differences between the second and the
+// first of each adjacent pair of elements of the range [first, last) and
+// writes them to the range beginning at dFirst + 1. An unmodified copy of
+// first is written to dFirst. Differences are calculated by cur-prev. The
+// output range must not overlap the input range.
+func AdjacentDifference[T iter.Numeric, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out) Out {
+	return AdjacentDifferenceBy(p, first, last, dFirst, func(x, y T) T { return x - y })
+}
+
+// AdjacentDifferenceBy computes the differences between the second and the
+// first of each adjacent pair of elements of the range [first, last) and
+// writes them to the range beginning at dFirst + 1. An unmodified copy of
+// first is written to dFirst. Differences are calculated by sub(cur,prev). The
+// output range must not overlap the input range.
+func AdjacentDifferenceBy[T any, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, first, last In, dFirst Out, sub algo.BinaryOperation[T, T, T]) Out {
+	n := first.Distance(last)
+	run(p.chunks(n), n, func(c, lo, hi int) {
+		if lo == hi {
+			return
+		}
+		prev, it, out := first.AdvanceN(lo), first.AdvanceN(lo), dFirst.AdvanceN(lo)
+		if lo == 0 {
+			out.Write(it.Read())
+			it, out = it.Next(), out.Next()
+		} else {
+			prev = prev.Prev()
+		}
+		for end := first.AdvanceN(hi); !it.Eq(end); it, out = it.Next(), out.Next() {
+			out.Write(sub(it.Read(), prev.Read()))
+			prev = it
+		}
+	})
+	return dFirst.AdvanceN(n)
+}
+
+func add[T iter.Numeric](x, y T) T {
+	return x + y
+}
+
+func noop[T any](x T) T {
+	return x
+}
@@ -0,0 +1,93 @@
+package par_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/par"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 10, 1000, 10000} {
+			a := randInts(n)
+			first, last := slices.Begin(a), slices.End(a)
+			want, got := make([]int, n), make([]int, n)
+
+			algo.InclusiveScan(first, last, slices.Begin(want), 3)
+			end := InclusiveScan(p, first, last, slices.Begin(got), 3)
+			assert.Equal(want, got)
+			assert.True(end.Eq(slices.End(got)))
+
+			algo.ExclusiveScan(first, last, slices.Begin(want), 3)
+			ExclusiveScan(p, first, last, slices.Begin(got), 3)
+			assert.Equal(want, got)
+
+			square := func(x int) int { return x * x }
+			algo.TransformInclusiveScan(first, last, slices.Begin(want), 0, square)
+			TransformInclusiveScan(p, first, last, slices.Begin(got), 0, square)
+			assert.Equal(want, got)
+
+			algo.TransformExclusiveScan(first, last, slices.Begin(want), 0, square)
+			TransformExclusiveScan(p, first, last, slices.Begin(got), 0, square)
+			assert.Equal(want, got)
+
+			// in place
+			algo.InclusiveScan(first, last, slices.Begin(want), 0)
+			InclusiveScan(p, first, last, first, 0)
+			assert.Equal(want, a)
+		}
+	}
+}
+
+func TestScanNonCommutative(t *testing.T) {
+	assert := assert.New(t)
+	// String concatenation is associative but not commutative, so the result
+	// only matches if the chunks are combined in order.
+	concat := func(x, y string) string { return x + y }
+	for _, p := range policies {
+		a := randInts(3000)
+		s := make([]string, len(a))
+		algo.Transform(slices.Begin(a), slices.End(a), slices.Begin(s), strconv.Itoa)
+		want, got := make([]string, len(s)), make([]string, len(s))
+
+		algo.InclusiveScanBy(slices.Begin(s), slices.End(s), slices.Begin(want), ">", concat)
+		InclusiveScanBy(p, slices.Begin(s), slices.End(s), slices.Begin(got), ">", concat)
+		assert.Equal(want, got)
+
+		algo.ExclusiveScanBy(slices.Begin(s), slices.End(s), slices.Begin(want), ">", concat)
+		ExclusiveScanBy(p, slices.Begin(s), slices.End(s), slices.Begin(got), ">", concat)
+		assert.Equal(want, got)
+
+		algo.TransformInclusiveScanBy(slices.Begin(a), slices.End(a), slices.Begin(want), "", concat, strconv.Itoa)
+		TransformInclusiveScanBy(p, slices.Begin(a), slices.End(a), slices.Begin(got), "", concat, strconv.Itoa)
+		assert.Equal(want, got)
+
+		algo.TransformExclusiveScanBy(slices.Begin(a), slices.End(a), slices.Begin(want), "", concat, strconv.Itoa)
+		TransformExclusiveScanBy(p, slices.Begin(a), slices.End(a), slices.Begin(got), "", concat, strconv.Itoa)
+		assert.Equal(want, got)
+	}
+}
+
+func TestAdjacentDifference(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 2, 10, 1000, 10000} {
+			a := randInts(n)
+			first, last := slices.Begin(a), slices.End(a)
+			want, got := make([]int, n), make([]int, n)
+			algo.AdjacentDifference(first, last, slices.Begin(want))
+			end := AdjacentDifference(p, first, last, slices.Begin(got))
+			assert.Equal(want, got)
+			assert.True(end.Eq(slices.End(got)))
+
+			// The inverse of a scan.
+			InclusiveScan(p, slices.Begin(got), slices.End(got), slices.Begin(got), 0)
+			assert.Equal(a, got)
+		}
+	}
+}
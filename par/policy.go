@@ -0,0 +1,72 @@
+package par
+
+import (
+	"runtime"
+	"sync"
+)
+
+// DefaultGrain is the grain size used by a Policy with a non-positive Grain.
+const DefaultGrain = 2048
+
+// Policy controls how an algorithm splits its work. The zero value uses
+// GOMAXPROCS goroutines and DefaultGrain.
+type Policy struct {
+	// Workers is the maximum number of goroutines, including the calling one.
+	// If Workers <= 0, runtime.GOMAXPROCS(0) is used.
+	Workers int
+	// Grain is the minimum number of elements of a chunk. Ranges shorter than
+	// twice Grain are processed by the calling goroutine alone. If Grain <= 0,
+	// DefaultGrain is used.
+	Grain int
+}
+
+// Default is the zero Policy.
+var Default Policy
+
+// chunks returns the number of chunks to split n elements into.
+func (p Policy) chunks(n int) int {
+	w, g := p.Workers, p.Grain
+	if w <= 0 {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if g <= 0 {
+		g = DefaultGrain
+	}
+	return max(1, min(w, n/g))
+}
+
+// run splits [0, n) into k contiguous chunks, and calls body for each of them
+// concurrently. It returns after all calls have returned, and re-panics with
+// the first recovered panic, if any.
+func run(k, n int, body func(c, lo, hi int)) {
+	if k == 1 {
+		body(0, 0, n)
+		return
+	}
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicked bool
+		value    any
+	)
+	call := func(c int) {
+		defer func() {
+			if r := recover(); r != nil {
+				once.Do(func() { panicked, value = true, r })
+			}
+		}()
+		body(c, c*n/k, (c+1)*n/k)
+	}
+	for c := 1; c < k; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			call(c)
+		}(c)
+	}
+	call(0)
+	wg.Wait()
+	if panicked {
+		panic(value)
+	}
+}
@@ -0,0 +1,121 @@
+package par
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+)
+
+// Sort sorts the elements in the range [first, last) in ascending order. The
+// order of equal elements is not guaranteed to be preserved.
+func Sort[T iter.Ordered, It iter.RandomReadWriter[T, It]](p Policy, first, last It) {
+	SortBy(p, first, last, func(x, y T) bool { return x < y })
+}
+
+// SortBy sorts the elements in the range [first, last) in ascending order. The
+// order of equal elements is not guaranteed to be preserved.
+//
+// Elements are compared using the given binary comparer less.
+func SortBy[T any, It iter.RandomReadWriter[T, It]](p Policy, first, last It, less algo.LessComparer[T]) {
+	mergeSort(p, first, last, less, algo.SortBy[T, It])
+}
+
+// StableSort sorts the elements in the range [first, last) in ascending order.
+// The order of equal elements is preserved.
+func StableSort[T iter.Ordered, It iter.RandomReadWriter[T, It]](p Policy, first, last It) {
+	StableSortBy(p, first, last, func(x, y T) bool { return x < y })
+}
+
+// StableSortBy sorts the elements in the range [first, last) in ascending
+// order. The order of equal elements is preserved, so the result is the same
+// as the one of algo.StableSortBy.
+//
+// Elements are compared using the given binary comparer less.
+func StableSortBy[T any, It iter.RandomReadWriter[T, It]](p Policy, first, last It, less algo.LessComparer[T]) {
+	mergeSort(p, first, last, less, algo.StableSortBy[T, It])
+}
+
+// mergeSort sorts chunks of [first, last) concurrently with sort, then merges
+// adjacent runs pairwise. The runs are merged back and forth between the range
+// and a buffer, so that each round can be split into independent merges of
+// equal output size. Merging prefers the left run, so it is stable if sort is.
+func mergeSort[T any, It iter.RandomReadWriter[T, It]](p Policy, first, last It, less algo.LessComparer[T], sort func(It, It, algo.LessComparer[T])) {
+	n := first.Distance(last)
+	k := p.chunks(n)
+	bounds := make([]int, k+1)
+	for c := range bounds {
+		bounds[c] = c * n / k
+	}
+	run(k, n, func(_, lo, hi int) {
+		sort(first.AdvanceN(lo), first.AdvanceN(hi), less)
+	})
+	if k == 1 {
+		return
+	}
+	buf := slices.Begin(make([]T, n))
+	inBuf := false
+	for ; len(bounds) > 2; inBuf = !inBuf {
+		if inBuf {
+			mergeRound(p, buf, first, bounds, less)
+		} else {
+			mergeRound(p, first, buf, bounds, less)
+		}
+		merged := bounds[:0:0]
+		for c := 0; c < len(bounds); c += 2 {
+			merged = append(merged, bounds[c])
+		}
+		if len(bounds)%2 == 0 {
+			merged = append(merged, bounds[len(bounds)-1])
+		}
+		bounds = merged
+	}
+	if inBuf {
+		run(p.chunks(n), n, func(_, lo, hi int) {
+			algo.Copy[T](buf.AdvanceN(lo), buf.AdvanceN(hi), first.AdvanceN(lo))
+		})
+	}
+}
+
+// mergeRound merges each pair of adjacent runs of src delimited by bounds into
+// the same positions of dst, and copies a last unpaired run. The output is
+// split into chunks that are merged concurrently, each from the co-ranked
+// positions of its runs.
+func mergeRound[T any, In iter.RandomReader[T, In], Out iter.RandomWriter[T, Out]](p Policy, src In, dst Out, bounds []int, less algo.LessComparer[T]) {
+	n := bounds[len(bounds)-1]
+	run(p.chunks(n), n, func(_, lo, hi int) {
+		for c := 0; c+1 < len(bounds); c += 2 {
+			if c+2 == len(bounds) {
+				if from, to := max(lo, bounds[c]), min(hi, bounds[c+1]); from < to {
+					algo.Copy[T](src.AdvanceN(from), src.AdvanceN(to), dst.AdvanceN(from))
+				}
+				break
+			}
+			l, mid, r := bounds[c], bounds[c+1], bounds[c+2]
+			from, to := max(lo, l), min(hi, r)
+			if from >= to {
+				continue
+			}
+			i1, j1 := coRank(src, l, mid, r, from, less)
+			i2, j2 := coRank(src, l, mid, r, to, less)
+			algo.MergeBy(src.AdvanceN(i1), src.AdvanceN(i2), src.AdvanceN(j1), src.AdvanceN(j2), dst.AdvanceN(from), less)
+		}
+	})
+}
+
+// coRank returns the positions i in [l, mid] and j in [mid, r] such that the
+// stable merge of the runs [l, mid) and [mid, r) of src writes the elements
+// before i and j to the positions before t.
+func coRank[T any, It iter.RandomReader[T, It]](src It, l, mid, r, t int, less algo.LessComparer[T]) (int, int) {
+	k := t - l
+	// Binary search the number of elements taken from the left run.
+	lo, hi := max(0, k-(r-mid)), min(k, mid-l)
+	for lo < hi {
+		i := int(uint(lo+hi) >> 1)
+		if !less(src.AdvanceN(mid+k-i-1).Read(), src.AdvanceN(l+i).Read()) {
+			lo = i + 1
+		} else {
+			hi = i
+		}
+	}
+	return l + lo, mid + k - lo
+}
@@ -0,0 +1,89 @@
+package par_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/par"
+	iterslices "github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct{ k, i int }
+
+func TestSort(t *testing.T) {
+	assert := assert.New(t)
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 2, 9, 100, 5000} {
+			a := randInts(n)
+			want := slices.Clone(a)
+			slices.Sort(want)
+			Sort(p, iterslices.Begin(a), iterslices.End(a))
+			assert.Equal(want, a)
+
+			a = randInts(n)
+			SortBy(p, iterslices.RBegin(a), iterslices.REnd(a), func(x, y int) bool { return x < y })
+			slices.Reverse(want)
+			assert.Equal(want, a)
+		}
+	}
+}
+
+func TestStableSort(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	less := func(x, y item) bool { return x.k < y.k }
+	for _, p := range policies {
+		for _, n := range []int{0, 1, 3, 100, 5000} {
+			a := make([]item, n)
+			for i := range a {
+				a[i] = item{r.Intn(10), i}
+			}
+			want := slices.Clone(a)
+			algo.StableSortBy(iterslices.Begin(want), iterslices.End(want), less)
+			StableSortBy(p, iterslices.Begin(a), iterslices.End(a), less)
+			assert.Equal(want, a)
+
+			b := randInts(n)
+			want2 := slices.Clone(b)
+			slices.Sort(want2)
+			StableSort(p, iterslices.Begin(b), iterslices.End(b))
+			assert.Equal(want2, b)
+		}
+	}
+}
+
+func TestSortPanic(t *testing.T) {
+	a := randInts(1000)
+	a[500] = 999
+	assert.PanicsWithValue(t, "boom", func() {
+		SortBy(Policy{Workers: 4, Grain: 10}, iterslices.Begin(a), iterslices.End(a), func(x, y int) bool {
+			if x == 999 || y == 999 {
+				panic("boom")
+			}
+			return x < y
+		})
+	})
+}
+
+func TestStableSortRounds(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(2))
+	less := func(x, y item) bool { return x.k < y.k }
+	// Every number of runs from 2 to 9, so that both even and odd numbers of
+	// merge rounds happen, with runs of all keys equal or mostly distinct.
+	for w := 2; w <= 9; w++ {
+		for _, keys := range []int{1, 3, 1000} {
+			a := make([]item, 997)
+			for i := range a {
+				a[i] = item{r.Intn(keys), i}
+			}
+			want := slices.Clone(a)
+			algo.StableSortBy(iterslices.Begin(want), iterslices.End(want), less)
+			StableSortBy(Policy{Workers: w, Grain: 1}, iterslices.Begin(a), iterslices.End(a), less)
+			assert.Equal(want, a)
+		}
+	}
+}
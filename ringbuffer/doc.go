@@ -0,0 +1,7 @@
+// Package ringbuffer provides RingBuffer, a fixed-capacity circular buffer.
+//
+// Elements are addressed by their logical position, 0 being the oldest one,
+// regardless of where they are stored. The iterators are random access across
+// the wrap point, so algorithms such as algo.Sort and algo.NthElement work on
+// the buffered window in place.
+package ringbuffer
@@ -0,0 +1,120 @@
+package ringbuffer
+
+import "fmt"
+
+// Mode decides what PushBack does when the buffer is full.
+type Mode int
+
+const (
+	// Overwrite drops the oldest element to make room for the new one.
+	Overwrite Mode = iota
+	// Reject keeps the buffer unchanged and discards the new element.
+	Reject
+)
+
+// RingBuffer is a circular buffer with a fixed capacity.
+type RingBuffer[T any] struct {
+	buf  []T
+	head int
+	n    int
+	mode Mode
+}
+
+// New returns an empty buffer that holds up to capacity elements. It panics if
+// capacity is not positive.
+func New[T any](capacity int, mode Mode) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("ringbuffer: non-positive capacity")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), mode: mode}
+}
+
+// Len returns the number of elements.
+func (r *RingBuffer[T]) Len() int {
+	return r.n
+}
+
+// Cap returns the capacity.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Full reports whether the buffer holds Cap() elements.
+func (r *RingBuffer[T]) Full() bool {
+	return r.n == len(r.buf)
+}
+
+func (r *RingBuffer[T]) index(i int) int {
+	if i < 0 || i >= r.n {
+		panic(fmt.Sprintf("ringbuffer: index %d out of range [0, %d)", i, r.n))
+	}
+	if i += r.head; i >= len(r.buf) {
+		i -= len(r.buf)
+	}
+	return i
+}
+
+// At returns the i'th oldest element.
+func (r *RingBuffer[T]) At(i int) T {
+	return r.buf[r.index(i)]
+}
+
+// Set sets the i'th oldest element to v.
+func (r *RingBuffer[T]) Set(i int, v T) {
+	r.buf[r.index(i)] = v
+}
+
+// Front returns the oldest element. The buffer must not be empty.
+func (r *RingBuffer[T]) Front() T {
+	return r.At(0)
+}
+
+// Back returns the newest element. The buffer must not be empty.
+func (r *RingBuffer[T]) Back() T {
+	return r.At(r.n - 1)
+}
+
+// PushBack appends v as the newest element. It reports whether v was
+// appended, which is false only if the buffer is full in Reject mode.
+func (r *RingBuffer[T]) PushBack(v T) bool {
+	if r.Full() {
+		if r.mode == Reject {
+			return false
+		}
+		r.PopFront()
+	}
+	r.n++
+	r.buf[r.index(r.n-1)] = v
+	return true
+}
+
+// PopFront removes the oldest element and returns it. The buffer must not be
+// empty.
+func (r *RingBuffer[T]) PopFront() T {
+	i := r.index(0)
+	v := r.buf[i]
+	var zero T
+	r.buf[i] = zero
+	if r.head++; r.head == len(r.buf) {
+		r.head = 0
+	}
+	r.n--
+	return v
+}
+
+// Clear removes all elements.
+func (r *RingBuffer[T]) Clear() {
+	for r.n > 0 {
+		r.PopFront()
+	}
+	r.head = 0
+}
+
+// Values returns a copy of the elements from the oldest to the newest.
+func (r *RingBuffer[T]) Values() []T {
+	s := make([]T, r.n)
+	for i := range s {
+		s[i] = r.At(i)
+	}
+	return s
+}
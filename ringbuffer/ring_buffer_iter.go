@@ -0,0 +1,101 @@
+package ringbuffer
+
+import "fmt"
+
+// Iterator is a random-access iterator over the elements of a RingBuffer in
+// logical order. PushBack and PopFront shift the logical positions, so they
+// invalidate all iterators.
+type Iterator[T any] struct {
+	r    *RingBuffer[T]
+	i    int
+	step int
+}
+
+// Begin returns an iterator to the oldest element.
+func (r *RingBuffer[T]) Begin() Iterator[T] {
+	return Iterator[T]{r: r, i: 0, step: 1}
+}
+
+// End returns an iterator to the passed newest element.
+func (r *RingBuffer[T]) End() Iterator[T] {
+	return Iterator[T]{r: r, i: r.n, step: 1}
+}
+
+// RBegin returns an iterator to the newest element.
+func (r *RingBuffer[T]) RBegin() Iterator[T] {
+	return Iterator[T]{r: r, i: r.n - 1, step: -1}
+}
+
+// REnd returns an iterator to the passed oldest element.
+func (r *RingBuffer[T]) REnd() Iterator[T] {
+	return Iterator[T]{r: r, i: -1, step: -1}
+}
+
+func (it Iterator[T]) String() string {
+	dir := "->"
+	if it.step < 0 {
+		dir = "<-"
+	}
+	return fmt.Sprintf("%v@%d%s", it.r.Values(), it.i, dir)
+}
+
+// Index returns the logical position of the current element.
+func (it Iterator[T]) Index() int {
+	return it.i
+}
+
+func (it Iterator[T]) Read() T {
+	return it.r.At(it.i)
+}
+
+func (it Iterator[T]) Write(v T) {
+	it.r.Set(it.i, v)
+}
+
+func (it Iterator[T]) Eq(it2 Iterator[T]) bool {
+	return it.i == it2.i
+}
+
+func (it Iterator[T]) Less(it2 Iterator[T]) bool {
+	if it.step < 0 {
+		return it.i > it2.i
+	}
+	return it.i < it2.i
+}
+
+func (it Iterator[T]) AllowMultiplePass() {}
+
+func (it Iterator[T]) Next() Iterator[T] {
+	return it.AdvanceN(1)
+}
+
+func (it Iterator[T]) Prev() Iterator[T] {
+	return it.AdvanceN(-1)
+}
+
+func (it Iterator[T]) AdvanceN(n int) Iterator[T] {
+	return Iterator[T]{
+		r:    it.r,
+		i:    it.i + n*it.step,
+		step: it.step,
+	}
+}
+
+func (it Iterator[T]) Distance(it2 Iterator[T]) int {
+	return (it2.i - it.i) * it.step
+}
+
+// BackInserter is an output iterator that pushes values to a RingBuffer.
+type BackInserter[T any] struct {
+	r *RingBuffer[T]
+}
+
+// Appender returns an OutputIter to push elements to the back of the
+// RingBuffer. Values rejected by a full buffer in Reject mode are discarded.
+func Appender[T any](r *RingBuffer[T]) BackInserter[T] {
+	return BackInserter[T]{r: r}
+}
+
+func (bi BackInserter[T]) Write(v T) {
+	bi.r.PushBack(v)
+}
@@ -0,0 +1,95 @@
+package ringbuffer_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	. "github.com/disksing/iter/v2/ringbuffer"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.RandomReadWriter[int, Iterator[int]] = Iterator[int]{}
+	_ iter.OutputIter[int]                      = BackInserter[int]{}
+)
+
+func TestRingBuffer(t *testing.T) {
+	assert := assert.New(t)
+	r := New[int](3, Overwrite)
+	assert.Equal(0, r.Len())
+	assert.Equal(3, r.Cap())
+	for i := 1; i <= 5; i++ {
+		assert.True(r.PushBack(i))
+	}
+	assert.True(r.Full())
+	assert.Equal([]int{3, 4, 5}, r.Values())
+	assert.Equal(3, r.Front())
+	assert.Equal(5, r.Back())
+	r.Set(1, 40)
+	assert.Equal(40, r.At(1))
+	assert.Panics(func() { r.At(3) })
+	assert.Panics(func() { r.At(-1) })
+
+	assert.Equal(3, r.PopFront())
+	assert.Equal([]int{40, 5}, r.Values())
+	r.Clear()
+	assert.Equal(0, r.Len())
+	assert.Panics(func() { r.PopFront() })
+
+	r = New[int](2, Reject)
+	assert.True(r.PushBack(1))
+	assert.True(r.PushBack(2))
+	assert.False(r.PushBack(3))
+	assert.Equal([]int{1, 2}, r.Values())
+
+	assert.Panics(func() { New[int](0, Reject) })
+}
+
+func TestIterator(t *testing.T) {
+	assert := assert.New(t)
+	r := New[int](5, Overwrite)
+	algo.CopyN[int](iter.IotaReader(1), 7, Appender(r))
+	assert.Equal([]int{3, 4, 5, 6, 7}, r.Values())
+
+	r.Begin().AllowMultiplePass()
+	assert.Contains(fmt.Sprint(r.Begin()), "->")
+	assert.Contains(fmt.Sprint(r.RBegin()), "<-")
+	assert.Equal(5, r.Begin().Distance(r.End()))
+	assert.Equal(5, r.RBegin().Distance(r.REnd()))
+	assert.True(r.Begin().Less(r.End()))
+	assert.True(r.RBegin().Less(r.REnd()))
+	assert.Equal(2, r.Begin().AdvanceN(2).Index())
+	assert.Equal(3, r.REnd().Prev().Read())
+	assert.Equal(7, r.End().Prev().Read())
+
+	algo.Reverse[int](r.Begin(), r.End())
+	assert.Equal([]int{7, 6, 5, 4, 3}, r.Values())
+	algo.Sort[int](r.RBegin(), r.REnd())
+	assert.Equal([]int{7, 6, 5, 4, 3}, r.Values())
+	r.Begin().Write(0)
+	assert.Equal([]int{0, 6, 5, 4, 3}, r.Values())
+}
+
+func TestWindowAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	rnd := rand.New(rand.NewSource(1))
+	r := New[int](16, Overwrite)
+	for n := 0; n < 100; n++ {
+		r.PushBack(rnd.Intn(50))
+		want := r.Values()
+		sort.Ints(want)
+
+		k := rnd.Intn(r.Len())
+		nth := r.Begin().AdvanceN(k)
+		algo.NthElement[int](r.Begin(), nth, r.End())
+		assert.Equal(want[k], nth.Read())
+
+		algo.Sort[int](r.Begin(), r.End())
+		assert.Equal(want, r.Values())
+		assert.True(algo.BinarySearch[int](r.Begin(), r.End(), want[k]))
+	}
+}
@@ -112,6 +112,11 @@ func SearchBy[T1, T2 any](s1 []T1, s2 []T2, eq algo.EqComparer[T1, T2]) int {
 	return __idx(algo.SearchBy(Begin(s1), End(s1), Begin(s2), End(s2), eq))
 }
 
+// SearchWith searches s for the first occurrence of the pattern of searcher.
+func SearchWith[T any](s []T, searcher algo.Searcher[Iterator[T]]) int {
+	return __idx(algo.SearchWith(Begin(s), End(s), searcher))
+}
+
 // FindEnd searches for the last occurrence of s2 in s1.
 func FindEnd[T comparable](s1, s2 []T) int {
 	return __idx(algo.FindEnd[T](Begin(s1), End(s1), Begin(s2), End(s2)))
@@ -124,6 +129,72 @@ func FindEndBy[T1, T2 any](s1 []T1, s2 []T2, eq algo.EqComparer[T1, T2]) int {
 	return __idx(algo.FindEndBy(Begin(s1), End(s1), Begin(s2), End(s2), eq))
 }
 
+// FindLast returns the last position in the slice that is equal to value, or
+// len(s) if there is no such element.
+func FindLast[T comparable](s []T, x T) int {
+	return __idx(algo.FindLast(Begin(s), End(s), x))
+}
+
+// FindLastIf returns the last position in the slice which predicate pred
+// returns true, or len(s) if there is no such element.
+func FindLastIf[T any](s []T, pred algo.UnaryPredicate[T]) int {
+	return __idx(algo.FindLastIf(Begin(s), End(s), pred))
+}
+
+// FindLastIfNot returns the last position in the slice which predicate pred
+// returns false, or len(s) if there is no such element.
+func FindLastIfNot[T any](s []T, pred algo.UnaryPredicate[T]) int {
+	return __idx(algo.FindLastIfNot(Begin(s), End(s), pred))
+}
+
+// Contains checks if the slice contains an element equal to v.
+func Contains[T comparable](s []T, v T) bool {
+	return algo.Contains(Begin(s), End(s), v)
+}
+
+// ContainsBy checks if the slice contains an element equal to v.
+//
+// Elements are compared using the given binary comparer eq.
+func ContainsBy[T1, T2 any](s []T1, v T2, eq algo.EqComparer[T1, T2]) bool {
+	return algo.ContainsBy(Begin(s), End(s), v, eq)
+}
+
+// ContainsSubrange checks if s2 occurs in s1.
+func ContainsSubrange[T comparable](s1, s2 []T) bool {
+	return algo.ContainsSubrange[T](Begin(s1), End(s1), Begin(s2), End(s2))
+}
+
+// ContainsSubrangeBy checks if s2 occurs in s1.
+//
+// Elements are compared using the given binary comparer eq.
+func ContainsSubrangeBy[T1, T2 any](s1 []T1, s2 []T2, eq algo.EqComparer[T1, T2]) bool {
+	return algo.ContainsSubrangeBy(Begin(s1), End(s1), Begin(s2), End(s2), eq)
+}
+
+// StartsWith checks if s1 starts with s2.
+func StartsWith[T comparable](s1, s2 []T) bool {
+	return algo.StartsWith[T](Begin(s1), End(s1), Begin(s2), End(s2))
+}
+
+// StartsWithBy checks if s1 starts with s2.
+//
+// Elements are compared using the given binary comparer eq.
+func StartsWithBy[T1, T2 any](s1 []T1, s2 []T2, eq algo.EqComparer[T1, T2]) bool {
+	return algo.StartsWithBy(Begin(s1), End(s1), Begin(s2), End(s2), eq)
+}
+
+// EndsWith checks if s1 ends with s2.
+func EndsWith[T comparable](s1, s2 []T) bool {
+	return algo.EndsWith[T](Begin(s1), End(s1), Begin(s2), End(s2))
+}
+
+// EndsWithBy checks if s1 ends with s2.
+//
+// Elements are compared using the given binary comparer eq.
+func EndsWithBy[T1, T2 any](s1 []T1, s2 []T2, eq algo.EqComparer[T1, T2]) bool {
+	return algo.EndsWithBy(Begin(s1), End(s1), Begin(s2), End(s2), eq)
+}
+
 // SearchN searches for the first sequence of count elements equal to v.
 func SearchN[T comparable](s []T, count int, v T) int {
 	return __idx(algo.SearchN(Begin(s), End(s), count, v))
@@ -176,6 +247,24 @@ func Rotate[T any](s []T, newFirst int) int {
 	return __idx(algo.Rotate[T](Begin(s), Begin(s).AdvanceN(newFirst), End(s)))
 }
 
+// ShiftLeft shifts the elements of the slice by n positions towards the
+// beginning, sets the vacated elements to the zero value, and returns the
+// length of the shifted part.
+func ShiftLeft[T any](s []T, n int) int {
+	end := __idx(algo.ShiftLeft[T](Begin(s), End(s), n))
+	clear(s[end:])
+	return end
+}
+
+// ShiftRight shifts the elements of the slice by n positions towards the end,
+// sets the vacated elements to the zero value, and returns the position of the
+// first shifted element.
+func ShiftRight[T any](s []T, n int) int {
+	begin := __idx(algo.ShiftRight[T](Begin(s), End(s), n))
+	clear(s[:begin])
+	return begin
+}
+
 // Shuffle reorders the elements in the list such that each possible permutation
 // of those elements has equal probability of appearance.
 func Shuffle[T any](s []T, r *rand.Rand) {
@@ -444,6 +533,67 @@ func PrevPermutation[T iter.Ordered](s []T) bool {
 	return algo.PrevPermutation[T](Begin(s), End(s))
 }
 
+// NthPermutation transforms the sorted slice into its n'th permutation in
+// lexicographic order, counting from 0. The elements should be distinct.
+func NthPermutation[T any](s []T, n int) {
+	algo.NthPermutation[T](Begin(s), End(s), n)
+}
+
+// PermutationRank returns the position of the permutation s among all
+// permutations of its elements in lexicographic order, counting from 0. The
+// elements should be distinct.
+func PermutationRank[T iter.Ordered](s []T) int {
+	return algo.PermutationRank[T](Begin(s), End(s))
+}
+
+// PermutationRankBy returns the position of the permutation s among all
+// permutations of its elements in lexicographic order with respect to less,
+// counting from 0. The elements should be distinct.
+func PermutationRankBy[T any](s []T, less algo.LessComparer[T]) int {
+	return algo.PermutationRankBy(Begin(s), End(s), less)
+}
+
+// NextCombination transforms s[:k] into the next combination of k elements of
+// the slice in lexicographic order, keeping the rest sorted in s[k:]. Returns
+// true if such combination exists, otherwise transforms the slice into the
+// first combination (as if by Sort(s)) and returns false.
+func NextCombination[T iter.Ordered](s []T, k int) bool {
+	return algo.NextCombination[T](Begin(s), Begin(s).AdvanceN(k), End(s))
+}
+
+// NextCombinationBy transforms s[:k] into the next combination of k elements
+// of the slice in lexicographic order with respect to less, keeping the rest
+// sorted in s[k:]. Returns true if such combination exists, otherwise
+// transforms the slice into the first combination (as if by SortBy(s, less))
+// and returns false.
+func NextCombinationBy[T any](s []T, k int, less algo.LessComparer[T]) bool {
+	return algo.NextCombinationBy(Begin(s), Begin(s).AdvanceN(k), End(s), less)
+}
+
+// NextKPermutation transforms s[:k] into the next permutation of k elements of
+// the slice in lexicographic order, keeping the rest sorted in s[k:]. Returns
+// true if such permutation exists, otherwise transforms the slice into the
+// first one (as if by Sort(s)) and returns false.
+func NextKPermutation[T iter.Ordered](s []T, k int) bool {
+	return algo.NextKPermutation[T](Begin(s), Begin(s).AdvanceN(k), End(s))
+}
+
+// NextKPermutationBy transforms s[:k] into the next permutation of k elements
+// of the slice in lexicographic order with respect to less, keeping the rest
+// sorted in s[k:]. Returns true if such permutation exists, otherwise
+// transforms the slice into the first one (as if by SortBy(s, less)) and
+// returns false.
+func NextKPermutationBy[T any](s []T, k int, less algo.LessComparer[T]) bool {
+	return algo.NextKPermutationBy(Begin(s), Begin(s).AdvanceN(k), End(s), less)
+}
+
+// NextGraySubset transforms the subset marked by mask into the next subset in
+// binary reflected Gray code order, flipping exactly one flag. Returns true if
+// such subset exists, otherwise clears mask and returns false.
+func NextGraySubset(mask []bool) bool {
+	return algo.NextGraySubset(Begin(mask), End(mask))
+}
+
 // Accumulate computes the sum of the given value v and the elements in the
 // slice, using v+=x.
 func Accumulate[T iter.Numeric](s []T, v T) T {
@@ -456,6 +606,42 @@ func InnerProduct[T iter.Numeric](s1, s2 []T, v T) T {
 	return algo.InnerProduct(Begin(s1), End(s1), Begin(s2), v)
 }
 
+// Reduce computes the sum of the given value v and the elements in the slice,
+// in any order and grouping.
+func Reduce[T iter.Numeric](s []T, v T) T {
+	return algo.Reduce(Begin(s), End(s), v)
+}
+
+// TransformReduce applies op to each element in the slice, and computes the
+// sum of v and the results, in any order and grouping.
+func TransformReduce[T1 any, T2 iter.Numeric](s []T1, v T2, op algo.UnaryOperation[T1, T2]) T2 {
+	return algo.TransformReduce(Begin(s), End(s), v, op)
+}
+
+// FoldLeft folds the elements of the slice from the left, using v=f(v,x), and
+// returns v.
+func FoldLeft[T1, T2 any](s []T1, v T2, f algo.BinaryOperation[T2, T1, T2]) T2 {
+	return algo.FoldLeft(Begin(s), End(s), v, f)
+}
+
+// FoldLeftFirst folds the elements of the slice from the left, using the first
+// element as the initial value. It returns false if the slice is empty.
+func FoldLeftFirst[T any](s []T, f algo.BinaryOperation[T, T, T]) (T, bool) {
+	return algo.FoldLeftFirst(Begin(s), End(s), f)
+}
+
+// FoldRight folds the elements of the slice from the right, using v=f(x,v),
+// and returns v.
+func FoldRight[T1, T2 any](s []T1, v T2, f algo.BinaryOperation[T1, T2, T2]) T2 {
+	return algo.FoldRight(Begin(s), End(s), v, f)
+}
+
+// FoldRightLast folds the elements of the slice from the right, using the last
+// element as the initial value. It returns false if the slice is empty.
+func FoldRightLast[T any](s []T, f algo.BinaryOperation[T, T, T]) (T, bool) {
+	return algo.FoldRightLast(Begin(s), End(s), f)
+}
+
 // IsHeap reports whether s is a max heap.
 func IsHeap[T iter.Ordered](s []T) bool {
 	return algo.IsHeap[T](Begin(s), End(s))
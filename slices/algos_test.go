@@ -1,11 +1,13 @@
 package slices_test
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 	"testing"
 
 	iter "github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
 	iterslices "github.com/disksing/iter/v2/slices"
 )
 
@@ -183,3 +185,131 @@ func TestIsPermutationByFacade(t *testing.T) {
 		t.Fatal("IsPermutationBy() = false, want true")
 	}
 }
+
+func TestShiftFacade(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	if got := iterslices.ShiftLeft(values, 2); got != 3 {
+		t.Fatalf("ShiftLeft() = %d, want 3", got)
+	}
+	if want := []int{3, 4, 5, 0, 0}; !slices.Equal(values, want) {
+		t.Fatalf("ShiftLeft() left %v, want %v", values, want)
+	}
+	if got := iterslices.ShiftRight(values, 1); got != 1 {
+		t.Fatalf("ShiftRight() = %d, want 1", got)
+	}
+	if want := []int{0, 3, 4, 5, 0}; !slices.Equal(values, want) {
+		t.Fatalf("ShiftRight() left %v, want %v", values, want)
+	}
+	if got := iterslices.ShiftRight(values, 5); got != 5 {
+		t.Fatalf("ShiftRight() = %d, want 5", got)
+	}
+	if want := []int{0, 0, 0, 0, 0}; !slices.Equal(values, want) {
+		t.Fatalf("ShiftRight() left %v, want %v", values, want)
+	}
+}
+
+func TestReduceFacade(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	if got := iterslices.Reduce(values, 10); got != 20 {
+		t.Fatalf("Reduce() = %d, want 20", got)
+	}
+	if got := iterslices.TransformReduce(values, 0, func(x int) int { return x * x }); got != 30 {
+		t.Fatalf("TransformReduce() = %d, want 30", got)
+	}
+}
+
+func TestFoldAndContainsFacade(t *testing.T) {
+	values := []int{1, 2, 3, 2}
+	if got := iterslices.FoldLeft(values, "", func(s string, x int) string { return s + string(rune('0'+x)) }); got != "1232" {
+		t.Fatalf("FoldLeft() = %q, want 1232", got)
+	}
+	if got := iterslices.FoldRight(values, "", func(x int, s string) string { return s + string(rune('0'+x)) }); got != "2321" {
+		t.Fatalf("FoldRight() = %q, want 2321", got)
+	}
+	sub := func(x, y int) int { return x - y }
+	if got, ok := iterslices.FoldLeftFirst(values, sub); !ok || got != -6 {
+		t.Fatalf("FoldLeftFirst() = %d, %v, want -6, true", got, ok)
+	}
+	if got, ok := iterslices.FoldRightLast(values, sub); !ok || got != 0 {
+		t.Fatalf("FoldRightLast() = %d, %v, want 0, true", got, ok)
+	}
+	if _, ok := iterslices.FoldLeftFirst([]int{}, sub); ok {
+		t.Fatalf("FoldLeftFirst() of empty slice is ok")
+	}
+
+	if got := iterslices.FindLast(values, 2); got != 3 {
+		t.Fatalf("FindLast() = %d, want 3", got)
+	}
+	if got := iterslices.FindLast(values, 5); got != 4 {
+		t.Fatalf("FindLast() = %d, want 4", got)
+	}
+	if got := iterslices.FindLastIf(values, func(x int) bool { return x < 2 }); got != 0 {
+		t.Fatalf("FindLastIf() = %d, want 0", got)
+	}
+	if got := iterslices.FindLastIfNot(values, func(x int) bool { return x < 3 }); got != 2 {
+		t.Fatalf("FindLastIfNot() = %d, want 2", got)
+	}
+
+	eq := func(x int, y string) bool { return string(rune('0'+x)) == y }
+	switch {
+	case !iterslices.Contains(values, 3) || iterslices.Contains(values, 4):
+		t.Fatalf("Contains() is wrong")
+	case !iterslices.ContainsBy(values, "3", eq):
+		t.Fatalf("ContainsBy() is wrong")
+	case !iterslices.ContainsSubrange(values, []int{3, 2}) || iterslices.ContainsSubrange(values, []int{2, 1}):
+		t.Fatalf("ContainsSubrange() is wrong")
+	case !iterslices.ContainsSubrangeBy(values, []string{"2", "3"}, eq):
+		t.Fatalf("ContainsSubrangeBy() is wrong")
+	case !iterslices.StartsWith(values, []int{1, 2}) || iterslices.StartsWith(values, []int{2}):
+		t.Fatalf("StartsWith() is wrong")
+	case !iterslices.StartsWithBy(values, []string{"1"}, eq):
+		t.Fatalf("StartsWithBy() is wrong")
+	case !iterslices.EndsWith(values, []int{3, 2}) || iterslices.EndsWith(values, []int{1, 2, 3, 2, 1}):
+		t.Fatalf("EndsWith() is wrong")
+	case !iterslices.EndsWithBy(values, []string{"2"}, eq):
+		t.Fatalf("EndsWithBy() is wrong")
+	}
+}
+
+func TestSearchWithFacade(t *testing.T) {
+	s, p := []byte("abracadabra"), []byte("cad")
+	searcher := algo.NewBoyerMooreSearcher[iterslices.Iterator[byte]](iterslices.Begin(p), iterslices.End(p))
+	if got := iterslices.SearchWith(s, searcher); got != 4 {
+		t.Fatalf("SearchWith() = %d, want 4", got)
+	}
+	if got := iterslices.SearchWith(s[5:], searcher); got != 6 {
+		t.Fatalf("SearchWith() = %d, want 6", got)
+	}
+}
+
+func TestCombinatoricsFacade(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	var combs []string
+	for ok := true; ok; ok = iterslices.NextCombination(s, 2) {
+		combs = append(combs, fmt.Sprint(s[:2]))
+	}
+	if got := strings.Join(combs, " "); got != "[1 2] [1 3] [1 4] [2 3] [2 4] [3 4]" {
+		t.Fatalf("NextCombination() = %s", got)
+	}
+	var count int
+	for ok := true; ok; ok = iterslices.NextKPermutation(s, 2) {
+		count++
+	}
+	if count != 12 || !slices.Equal(s, []int{1, 2, 3, 4}) {
+		t.Fatalf("NextKPermutation() count = %d, s = %v", count, s)
+	}
+
+	iterslices.NthPermutation(s, 17)
+	if !slices.Equal(s, []int{3, 4, 2, 1}) || iterslices.PermutationRank(s) != 17 {
+		t.Fatalf("NthPermutation() = %v, rank %d", s, iterslices.PermutationRank(s))
+	}
+
+	mask := []bool{false, false}
+	var codes []string
+	for ok := true; ok; ok = iterslices.NextGraySubset(mask) {
+		codes = append(codes, fmt.Sprint(mask))
+	}
+	if got := strings.Join(codes, " "); got != "[false false] [true false] [true true] [false true]" {
+		t.Fatalf("NextGraySubset() = %s", got)
+	}
+}
@@ -1,4 +1,6 @@
 // Package slices provides direct generic APIs for algorithms over slices.
 //
-// It also exposes Iterator and Appender for using slices with package algo.
+// It also exposes Iterator and Appender for using slices with package algo,
+// Matrix for running algorithms along the rows, columns, diagonals, and blocks
+// of a flat slice, and SortedSet and SortedMap for sorted-slice containers.
 package slices
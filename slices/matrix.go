@@ -0,0 +1,164 @@
+package slices
+
+import "fmt"
+
+// Matrix is a row-major view of a flat slice as a two-dimensional matrix, like
+// C++ std::mdspan. Its rows, columns, diagonal, and blocks can be passed to
+// package algo without copying.
+type Matrix[T any] struct {
+	s          []T
+	offset     int
+	rows, cols int
+	stride     int
+}
+
+// NewMatrix returns a rows x cols view of s, where s[i*cols+j] is the element
+// at row i and column j. It panics if s has fewer than rows*cols elements.
+func NewMatrix[T any](s []T, rows, cols int) Matrix[T] {
+	if rows < 0 || cols < 0 || len(s) < rows*cols {
+		panic(fmt.Sprintf("slices: cannot view %d elements as %dx%d matrix", len(s), rows, cols))
+	}
+	return Matrix[T]{s: s, rows: rows, cols: cols, stride: cols}
+}
+
+// Rows returns the number of rows.
+func (m Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns.
+func (m Matrix[T]) Cols() int {
+	return m.cols
+}
+
+func (m Matrix[T]) pos(i, j int) int {
+	return m.offset + i*m.stride + j
+}
+
+func (m Matrix[T]) checkRow(i int) {
+	if i < 0 || i >= m.rows {
+		panic(fmt.Sprintf("slices: row %d out of %dx%d matrix", i, m.rows, m.cols))
+	}
+}
+
+func (m Matrix[T]) checkCol(j int) {
+	if j < 0 || j >= m.cols {
+		panic(fmt.Sprintf("slices: column %d out of %dx%d matrix", j, m.rows, m.cols))
+	}
+}
+
+// index returns the position in s of the element at row i and column j. It
+// panics if the element is out of the matrix.
+func (m Matrix[T]) index(i, j int) int {
+	if i < 0 || j < 0 || i >= m.rows || j >= m.cols {
+		panic(fmt.Sprintf("slices: index [%d, %d] out of %dx%d matrix", i, j, m.rows, m.cols))
+	}
+	return m.pos(i, j)
+}
+
+// At returns the element at row i and column j. It panics if the element is
+// out of the matrix.
+func (m Matrix[T]) At(i, j int) T {
+	return m.s[m.index(i, j)]
+}
+
+// Set sets the element at row i and column j. It panics if the element is out
+// of the matrix.
+func (m Matrix[T]) Set(i, j int, v T) {
+	m.s[m.index(i, j)] = v
+}
+
+// Row returns the range of the elements in row i. It panics if the row is out
+// of the matrix.
+func (m Matrix[T]) Row(i int) (Iterator[T], Iterator[T]) {
+	m.checkRow(i)
+	return StrideBegin(m.s, m.pos(i, 0), 1), StrideEnd(m.s, m.pos(i, 0), 1, m.cols)
+}
+
+// Col returns the range of the elements in column j. It panics if the column
+// is out of the matrix.
+func (m Matrix[T]) Col(j int) (Iterator[T], Iterator[T]) {
+	m.checkCol(j)
+	return StrideBegin(m.s, m.pos(0, j), m.stride), StrideEnd(m.s, m.pos(0, j), m.stride, m.rows)
+}
+
+// Diag returns the range of the elements in the main diagonal.
+func (m Matrix[T]) Diag() (Iterator[T], Iterator[T]) {
+	return StrideBegin(m.s, m.offset, m.stride+1), StrideEnd(m.s, m.offset, m.stride+1, min(m.rows, m.cols))
+}
+
+// Block returns the view of rows [r0, r1) and columns [c0, c1) of m. It panics
+// if the block is out of range.
+func (m Matrix[T]) Block(r0, c0, r1, c1 int) Matrix[T] {
+	if r0 < 0 || c0 < 0 || r0 > r1 || c0 > c1 || r1 > m.rows || c1 > m.cols {
+		panic(fmt.Sprintf("slices: block [%d:%d, %d:%d] out of %dx%d matrix", r0, r1, c0, c1, m.rows, m.cols))
+	}
+	return Matrix[T]{
+		s:      m.s,
+		offset: m.pos(r0, c0),
+		rows:   r1 - r0,
+		cols:   c1 - c0,
+		stride: m.stride,
+	}
+}
+
+// Begin returns an iterator to the first element of the matrix. The elements
+// are visited in row-major order.
+func (m Matrix[T]) Begin() MatrixIterator[T] {
+	return MatrixIterator[T]{m: m}
+}
+
+// End returns an iterator to the passed last element of the matrix.
+func (m Matrix[T]) End() MatrixIterator[T] {
+	return MatrixIterator[T]{m: m, k: m.rows * m.cols}
+}
+
+// MatrixIterator is a random-access iterator over the elements of a Matrix in
+// row-major order.
+type MatrixIterator[T any] struct {
+	m Matrix[T]
+	k int
+}
+
+func (it MatrixIterator[T]) index() int {
+	return it.m.pos(it.k/it.m.cols, it.k%it.m.cols)
+}
+
+// Pos returns the row and column of the current element.
+func (it MatrixIterator[T]) Pos() (int, int) {
+	return it.k / it.m.cols, it.k % it.m.cols
+}
+
+func (it MatrixIterator[T]) Read() T {
+	return it.m.s[it.index()]
+}
+
+func (it MatrixIterator[T]) Write(v T) {
+	it.m.s[it.index()] = v
+}
+
+func (it MatrixIterator[T]) Eq(it2 MatrixIterator[T]) bool {
+	return it.k == it2.k
+}
+
+func (it MatrixIterator[T]) Less(it2 MatrixIterator[T]) bool {
+	return it.k < it2.k
+}
+
+func (it MatrixIterator[T]) AllowMultiplePass() {}
+
+func (it MatrixIterator[T]) Next() MatrixIterator[T] {
+	return it.AdvanceN(1)
+}
+
+func (it MatrixIterator[T]) Prev() MatrixIterator[T] {
+	return it.AdvanceN(-1)
+}
+
+func (it MatrixIterator[T]) AdvanceN(n int) MatrixIterator[T] {
+	return MatrixIterator[T]{m: it.m, k: it.k + n}
+}
+
+func (it MatrixIterator[T]) Distance(it2 MatrixIterator[T]) int {
+	return it2.k - it.k
+}
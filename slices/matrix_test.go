@@ -0,0 +1,95 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ iter.RandomReadWriter[int, slices.MatrixIterator[int]] = slices.MatrixIterator[int]{}
+
+func collect[T any, It iter.InputIter[T, It]](first, last It) []T {
+	var ret []T
+	algo.Copy[T](first, last, slices.Appender(&ret))
+	return ret
+}
+
+func TestStrideIterator(t *testing.T) {
+	assert := assert.New(t)
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	first, last := slices.StrideBegin(s, 1, 3), slices.StrideEnd(s, 1, 3, 3)
+	assert.Equal([]int{1, 4, 7}, collect[int](first, last))
+	assert.Equal(3, first.Distance(last))
+	assert.Equal(-3, last.Distance(first))
+	assert.True(first.Less(last))
+	assert.Equal(4, last.Prev().Prev().Read())
+
+	first, last = slices.StrideBegin(s, 9, -4), slices.StrideEnd(s, 9, -4, 3)
+	assert.Equal([]int{9, 5, 1}, collect[int](first, last))
+	assert.Equal(3, iter.Distance[int](first, last))
+	assert.True(first.Less(last))
+	assert.False(last.Less(first))
+
+	assert.Panics(func() { slices.StrideBegin(s, 0, 0) })
+}
+
+func TestMatrix(t *testing.T) {
+	assert := assert.New(t)
+	s := make([]int, 12)
+	algo.Iota(slices.Begin(s), slices.End(s), 0)
+	m := slices.NewMatrix(s, 3, 4)
+	// 0  1  2  3
+	// 4  5  6  7
+	// 8  9 10 11
+	assert.Equal(3, m.Rows())
+	assert.Equal(4, m.Cols())
+	assert.Equal(6, m.At(1, 2))
+
+	assert.Equal([]int{4, 5, 6, 7}, collect[int](m.Row(1)))
+	assert.Equal([]int{2, 6, 10}, collect[int](m.Col(2)))
+	assert.Equal([]int{0, 5, 10}, collect[int](m.Diag()))
+	assert.Equal(s, collect[int](m.Begin(), m.End()))
+
+	b := m.Block(1, 1, 3, 3)
+	assert.Equal([]int{5, 6, 9, 10}, collect[int](b.Begin(), b.End()))
+	assert.Equal([]int{6, 10}, collect[int](b.Col(1)))
+	assert.Equal([]int{5, 10}, collect[int](b.Diag()))
+	assert.Equal(30, algo.Accumulate(b.Begin(), b.End(), 0))
+	r, c := b.Begin().AdvanceN(3).Pos()
+	assert.Equal([]int{1, 1}, []int{r, c})
+
+	b.Set(0, 0, 50)
+	assert.Equal(50, s[5])
+
+	first, last := m.Col(1)
+	algo.SortBy(first, last, func(x, y int) bool { return x > y })
+	assert.Equal([]int{0, 50, 2, 3, 4, 9, 6, 7, 8, 1, 10, 11}, s)
+
+	algo.Fill(b.Begin(), b.End(), -1)
+	assert.Equal([]int{0, 50, 2, 3, 4, -1, -1, 7, 8, -1, -1, 11}, s)
+
+	it := b.End().Prev()
+	assert.True(b.Begin().Less(it))
+	assert.Equal(3, b.Begin().Distance(it))
+	assert.True(it.Next().Eq(b.End()))
+
+	assert.Panics(func() { slices.NewMatrix(s, 4, 4) })
+	assert.Panics(func() { m.Block(0, 0, 4, 1) })
+	empty := m.Block(1, 1, 1, 3)
+	assert.True(empty.Begin().Eq(empty.End()))
+	assert.Panics(func() { empty.Row(0) })
+	first, last = empty.Col(1)
+	assert.True(first.Eq(last))
+
+	// indices are checked against the view, not the underlying slice
+	assert.Panics(func() { m.At(0, 4) })
+	assert.Panics(func() { m.Set(-1, 0, 0) })
+	assert.Panics(func() { b.At(1, 2) })
+	assert.Panics(func() { b.At(2, 0) })
+	assert.Panics(func() { b.Col(2) })
+	assert.Panics(func() { b.Row(-1) })
+}
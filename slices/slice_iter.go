@@ -5,7 +5,9 @@ import (
 	"strings"
 )
 
-// Iterator is a random-access iterator over a slice.
+// Iterator is a random-access iterator over a slice. It moves a fixed number of
+// elements at a time, which is 1 for Begin and End, -1 for RBegin and REnd, and
+// the given stride for StrideBegin and StrideEnd.
 type Iterator[T any] struct {
 	s    []T
 	i    int
@@ -32,6 +34,21 @@ func REnd[T any](s []T) Iterator[T] {
 	return Iterator[T]{s: s, i: -1, step: -1}
 }
 
+// StrideBegin returns an iterator to s[start] that moves stride elements at a
+// time. It panics if stride is 0.
+func StrideBegin[T any](s []T, start, stride int) Iterator[T] {
+	if stride == 0 {
+		panic("slices: zero stride")
+	}
+	return Iterator[T]{s: s, i: start, step: stride}
+}
+
+// StrideEnd returns an iterator to the passed last element of the n elements
+// starting at s[start] with the given stride. It panics if stride is 0.
+func StrideEnd[T any](s []T, start, stride, n int) Iterator[T] {
+	return StrideBegin(s, start, stride).AdvanceN(n)
+}
+
 func (it Iterator[T]) Read() T {
 	return it.s[it.i]
 }
@@ -85,7 +102,7 @@ func (it Iterator[T]) String() string {
 func (it Iterator[T]) AllowMultiplePass() {}
 
 func (it Iterator[T]) Distance(it2 Iterator[T]) int {
-	return (it2.i - it.i) * it.step
+	return (it2.i - it.i) / it.step
 }
 
 // BackInserter is an output iterator that appends values to a slice.
@@ -0,0 +1,153 @@
+package slices
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// SortedMap is a map stored as sorted slices of keys and values, like C++
+// std::flat_map. Lookups take O(log n) time; Insert and Erase of a new or
+// existing key take O(n) time.
+type SortedMap[K, V any] struct {
+	keys   []K
+	values []V
+	less   algo.LessComparer[K]
+}
+
+// NewSortedMap returns a map of keys[i] to values[i] in ascending key order. It
+// panics if keys and values have different lengths. It takes O(n log n) time.
+func NewSortedMap[K iter.Ordered, V any](keys []K, values []V) *SortedMap[K, V] {
+	return NewSortedMapBy(func(x, y K) bool { return x < y }, keys, values)
+}
+
+// NewSortedMapBy returns a map of keys[i] to values[i] with keys ordered by
+// less. Of equivalent keys, the first one and its value are kept. It panics if
+// keys and values have different lengths. It takes O(n log n) time.
+func NewSortedMapBy[K, V any](less algo.LessComparer[K], keys []K, values []V) *SortedMap[K, V] {
+	if len(keys) != len(values) {
+		panic("slices: keys and values have different lengths")
+	}
+	idx := make([]int, len(keys))
+	algo.Iota(Begin(idx), End(idx), 0)
+	StableSortBy(idx, func(i, j int) bool { return less(keys[i], keys[j]) })
+	idx = UniqueIf(idx, func(i, j int) bool { return !less(keys[i], keys[j]) })
+	m := &SortedMap[K, V]{
+		keys:   make([]K, len(idx)),
+		values: make([]V, len(idx)),
+		less:   less,
+	}
+	for i, j := range idx {
+		m.keys[i], m.values[i] = keys[j], values[j]
+	}
+	return m
+}
+
+// Len returns the number of entries.
+func (m *SortedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns the keys in ascending order. The returned slice shares storage
+// with the map and must not be modified.
+func (m *SortedMap[K, V]) Keys() []K {
+	return m.keys
+}
+
+// Values returns the values in ascending key order. The returned slice shares
+// storage with the map; its elements may be modified.
+func (m *SortedMap[K, V]) Values() []V {
+	return m.values
+}
+
+// Begin returns an iterator to the smallest key. The keys must not be modified
+// through the iterator.
+func (m *SortedMap[K, V]) Begin() Iterator[K] {
+	return Begin(m.keys)
+}
+
+// End returns an iterator to the passed largest key.
+func (m *SortedMap[K, V]) End() Iterator[K] {
+	return End(m.keys)
+}
+
+// LowerBound returns the position of the first key not less than k.
+func (m *SortedMap[K, V]) LowerBound(k K) int {
+	return LowerBoundBy(m.keys, k, m.less)
+}
+
+// UpperBound returns the position of the first key greater than k.
+func (m *SortedMap[K, V]) UpperBound(k K) int {
+	return UpperBoundBy(m.keys, k, m.less)
+}
+
+// Contains reports whether the map contains a key equivalent to k.
+func (m *SortedMap[K, V]) Contains(k K) bool {
+	return BinarySearchBy(m.keys, k, m.less)
+}
+
+// Find returns the position of the key equivalent to k, or Len() if there is
+// no such key.
+func (m *SortedMap[K, V]) Find(k K) int {
+	lo, hi := EqualRangeBy(m.keys, k, m.less)
+	if lo == hi {
+		return len(m.keys)
+	}
+	return lo
+}
+
+// Get returns the value of the key equivalent to k, and whether it exists.
+func (m *SortedMap[K, V]) Get(k K) (V, bool) {
+	if i := m.Find(k); i < len(m.keys) {
+		return m.values[i], true
+	}
+	var zero V
+	return zero, false
+}
+
+// Range returns the keys in [lo, hi) and their values. The returned slices
+// share storage with the map.
+func (m *SortedMap[K, V]) Range(lo, hi K) ([]K, []V) {
+	i := m.LowerBound(lo)
+	j := i + LowerBoundBy(m.keys[i:], hi, m.less)
+	return m.keys[i:j], m.values[i:j]
+}
+
+// Insert sets the value of key k to v, replacing the value of an equivalent
+// key. It reports whether k was inserted, that is, the map did not contain an
+// equivalent key.
+func (m *SortedMap[K, V]) Insert(k K, v V) bool {
+	i := m.LowerBound(k)
+	if i < len(m.keys) && !m.less(k, m.keys[i]) {
+		m.values[i] = v
+		return false
+	}
+	var zk K
+	var zv V
+	m.keys, m.values = append(m.keys, zk), append(m.values, zv)
+	copy(m.keys[i+1:], m.keys[i:])
+	copy(m.values[i+1:], m.values[i:])
+	m.keys[i], m.values[i] = k, v
+	return true
+}
+
+// Erase removes the entry of the key equivalent to k. It reports whether such
+// key was found.
+func (m *SortedMap[K, V]) Erase(k K) bool {
+	i := m.Find(k)
+	if i == len(m.keys) {
+		return false
+	}
+	m.EraseAt(i)
+	return true
+}
+
+// EraseAt removes the entry with the i'th smallest key.
+func (m *SortedMap[K, V]) EraseAt(i int) {
+	copy(m.keys[i:], m.keys[i+1:])
+	copy(m.values[i:], m.values[i+1:])
+	var zk K
+	var zv V
+	n := len(m.keys) - 1
+	m.keys[n], m.values[n] = zk, zv
+	m.keys, m.values = m.keys[:n], m.values[:n]
+}
@@ -0,0 +1,58 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedMap(t *testing.T) {
+	assert := assert.New(t)
+	m := slices.NewSortedMap([]string{"c", "a", "b", "a"}, []int{3, 1, 2, 100})
+	assert.Equal([]string{"a", "b", "c"}, m.Keys())
+	assert.Equal([]int{1, 2, 3}, m.Values())
+	assert.Equal(3, m.Len())
+
+	v, ok := m.Get("b")
+	assert.True(ok)
+	assert.Equal(2, v)
+	_, ok = m.Get("x")
+	assert.False(ok)
+	assert.True(m.Contains("c"))
+	assert.Equal(2, m.Find("c"))
+	assert.Equal(3, m.Find("d"))
+	assert.Equal(1, m.LowerBound("b"))
+	assert.Equal(2, m.UpperBound("b"))
+
+	assert.False(m.Insert("b", 20))
+	assert.True(m.Insert("bb", 22))
+	assert.True(m.Insert("0", 0))
+	assert.Equal([]string{"0", "a", "b", "bb", "c"}, m.Keys())
+	assert.Equal([]int{0, 1, 20, 22, 3}, m.Values())
+
+	keys, values := m.Range("a", "c")
+	assert.Equal([]string{"a", "b", "bb"}, keys)
+	assert.Equal([]int{1, 20, 22}, values)
+
+	assert.True(m.Erase("b"))
+	assert.False(m.Erase("b"))
+	m.EraseAt(0)
+	assert.Equal([]string{"a", "bb", "c"}, m.Keys())
+	assert.Equal([]int{1, 22, 3}, m.Values())
+
+	ss := slices.NewSortedSet("bb", "c", "d")
+	var common []string
+	algo.SetIntersection(m.Begin(), m.End(), ss.Begin(), ss.End(), slices.Appender(&common))
+	assert.Equal([]string{"bb", "c"}, common)
+
+	assert.Panics(func() { slices.NewSortedMap([]int{1}, []int{}) })
+}
+
+func TestSortedMapBy(t *testing.T) {
+	assert := assert.New(t)
+	m := slices.NewSortedMapBy(func(x, y int) bool { return x > y }, []int{1, 3, 2}, []string{"one", "three", "two"})
+	assert.Equal([]int{3, 2, 1}, m.Keys())
+	assert.Equal([]string{"three", "two", "one"}, m.Values())
+}
@@ -0,0 +1,120 @@
+package slices
+
+import (
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+)
+
+// SortedSet is a set stored as a sorted slice without duplicates, like C++
+// std::flat_set. Lookups take O(log n) time; Insert and Erase take O(n) time.
+type SortedSet[T any] struct {
+	s    []T
+	less algo.LessComparer[T]
+}
+
+// NewSortedSet returns a set of the given values in ascending order. It takes
+// O(n log n) time.
+func NewSortedSet[T iter.Ordered](values ...T) *SortedSet[T] {
+	return NewSortedSetBy(func(x, y T) bool { return x < y }, values...)
+}
+
+// NewSortedSetBy returns a set of the given values ordered by less. Of
+// equivalent values, the first one is kept. It takes O(n log n) time.
+func NewSortedSetBy[T any](less algo.LessComparer[T], values ...T) *SortedSet[T] {
+	s := append([]T(nil), values...)
+	StableSortBy(s, less)
+	s = UniqueIf(s, func(x, y T) bool { return !less(x, y) })
+	return &SortedSet[T]{s: s, less: less}
+}
+
+// Len returns the number of elements.
+func (ss *SortedSet[T]) Len() int {
+	return len(ss.s)
+}
+
+// At returns the i'th smallest element.
+func (ss *SortedSet[T]) At(i int) T {
+	return ss.s[i]
+}
+
+// Values returns the elements in ascending order. The returned slice shares
+// storage with the set and must not be modified.
+func (ss *SortedSet[T]) Values() []T {
+	return ss.s
+}
+
+// Begin returns an iterator to the smallest element. The elements must not be
+// modified through the iterator.
+func (ss *SortedSet[T]) Begin() Iterator[T] {
+	return Begin(ss.s)
+}
+
+// End returns an iterator to the passed largest element.
+func (ss *SortedSet[T]) End() Iterator[T] {
+	return End(ss.s)
+}
+
+// LowerBound returns the position of the first element not less than v.
+func (ss *SortedSet[T]) LowerBound(v T) int {
+	return LowerBoundBy(ss.s, v, ss.less)
+}
+
+// UpperBound returns the position of the first element greater than v.
+func (ss *SortedSet[T]) UpperBound(v T) int {
+	return UpperBoundBy(ss.s, v, ss.less)
+}
+
+// Contains reports whether the set contains an element equivalent to v.
+func (ss *SortedSet[T]) Contains(v T) bool {
+	return BinarySearchBy(ss.s, v, ss.less)
+}
+
+// Find returns the position of the element equivalent to v, or Len() if there
+// is no such element.
+func (ss *SortedSet[T]) Find(v T) int {
+	lo, hi := EqualRangeBy(ss.s, v, ss.less)
+	if lo == hi {
+		return len(ss.s)
+	}
+	return lo
+}
+
+// Range returns the elements in [lo, hi). The returned slice shares storage
+// with the set and must not be modified.
+func (ss *SortedSet[T]) Range(lo, hi T) []T {
+	i := ss.LowerBound(lo)
+	return ss.s[i : i+LowerBoundBy(ss.s[i:], hi, ss.less)]
+}
+
+// Insert adds v to the set. It reports whether v was inserted, that is, the
+// set did not contain an equivalent element.
+func (ss *SortedSet[T]) Insert(v T) bool {
+	i := ss.LowerBound(v)
+	if i < len(ss.s) && !ss.less(v, ss.s[i]) {
+		return false
+	}
+	var zero T
+	ss.s = append(ss.s, zero)
+	copy(ss.s[i+1:], ss.s[i:])
+	ss.s[i] = v
+	return true
+}
+
+// Erase removes the element equivalent to v. It reports whether such element
+// was found.
+func (ss *SortedSet[T]) Erase(v T) bool {
+	i := ss.Find(v)
+	if i == len(ss.s) {
+		return false
+	}
+	ss.EraseAt(i)
+	return true
+}
+
+// EraseAt removes the i'th smallest element.
+func (ss *SortedSet[T]) EraseAt(i int) {
+	copy(ss.s[i:], ss.s[i+1:])
+	var zero T
+	ss.s[len(ss.s)-1] = zero
+	ss.s = ss.s[:len(ss.s)-1]
+}
@@ -0,0 +1,61 @@
+package slices_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSet(t *testing.T) {
+	assert := assert.New(t)
+	ss := slices.NewSortedSet(5, 1, 4, 1, 3, 9, 5)
+	assert.Equal([]int{1, 3, 4, 5, 9}, ss.Values())
+	assert.Equal(5, ss.Len())
+	assert.Equal(4, ss.At(2))
+
+	assert.True(ss.Contains(4))
+	assert.False(ss.Contains(2))
+	assert.Equal(1, ss.Find(3))
+	assert.Equal(ss.Len(), ss.Find(7))
+	assert.Equal(1, ss.LowerBound(2))
+	assert.Equal(3, ss.UpperBound(4))
+	assert.Equal([]int{3, 4, 5}, ss.Range(2, 9))
+	assert.Empty(ss.Range(6, 9))
+
+	assert.True(ss.Insert(2))
+	assert.False(ss.Insert(2))
+	assert.True(ss.Insert(10))
+	assert.True(ss.Insert(0))
+	assert.Equal([]int{0, 1, 2, 3, 4, 5, 9, 10}, ss.Values())
+
+	assert.True(ss.Erase(4))
+	assert.False(ss.Erase(4))
+	ss.EraseAt(0)
+	assert.Equal([]int{1, 2, 3, 5, 9, 10}, ss.Values())
+
+	other := slices.NewSortedSet(2, 4, 5, 6, 10)
+	var inter, union []int
+	algo.SetIntersection(ss.Begin(), ss.End(), other.Begin(), other.End(), slices.Appender(&inter))
+	algo.SetUnion(ss.Begin(), ss.End(), other.Begin(), other.End(), slices.Appender(&union))
+	assert.Equal([]int{2, 5, 10}, inter)
+	assert.Equal([]int{1, 2, 3, 4, 5, 6, 9, 10}, union)
+}
+
+func TestSortedSetBy(t *testing.T) {
+	assert := assert.New(t)
+	less := func(x, y string) bool { return strings.ToLower(x) < strings.ToLower(y) }
+	ss := slices.NewSortedSetBy(less, "b", "A", "a", "C", "B")
+	assert.Equal([]string{"A", "b", "C"}, ss.Values())
+	assert.True(ss.Contains("c"))
+	assert.False(ss.Insert("B"))
+	assert.True(ss.Erase("a"))
+	assert.Equal([]string{"b", "C"}, ss.Values())
+
+	empty := slices.NewSortedSet[int]()
+	assert.Equal(0, empty.Len())
+	assert.False(empty.Erase(1))
+	assert.True(empty.Begin().Eq(empty.End()))
+}
@@ -1,5 +1,12 @@
-// Package strs provides byte iterators over strings and string output helpers.
+// Package strs provides byte, rune, token, and regexp match iterators over
+// strings and string output helpers.
 //
-// Convert a string to []rune and use package slices when iteration by rune is
-// required.
+// RuneIterator decodes UTF-8 in place, so iterating by rune does not require
+// converting a string to []rune. TokenIterator yields substrings lazily, so
+// splitting a string does not require building the whole token list.
+//
+// MatchIterator and RegexpTokenIterator are the exception: they find all the
+// matches when the first iterator is created, since package regexp cannot
+// resume a search at an offset without losing the context that ^, \b, and \B
+// depend on.
 package strs
@@ -0,0 +1,224 @@
+package strs
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Match is a successive regexp match yielded by MatchIterator.
+type Match[S string | []byte] struct {
+	src   S
+	index []int
+}
+
+// Text returns the text of the whole match.
+func (m Match[S]) Text() S {
+	return m.Submatch(0)
+}
+
+// Start returns the byte offset of the match in the source.
+func (m Match[S]) Start() int {
+	return m.index[0]
+}
+
+// End returns the byte offset just past the match in the source.
+func (m Match[S]) End() int {
+	return m.index[1]
+}
+
+// NumSubmatch returns the number of parenthesized subexpressions.
+func (m Match[S]) NumSubmatch() int {
+	return len(m.index)/2 - 1
+}
+
+// Submatch returns the text of the i'th subexpression; 0 is the whole match.
+// It returns the zero value if the subexpression did not participate in the
+// match.
+func (m Match[S]) Submatch(i int) S {
+	start, end := m.SubmatchIndex(i)
+	if start < 0 {
+		var zero S
+		return zero
+	}
+	return m.src[start:end]
+}
+
+// SubmatchIndex returns the byte offsets of the i'th subexpression, or -1, -1
+// if it did not participate in the match.
+func (m Match[S]) SubmatchIndex(i int) (int, int) {
+	return m.index[2*i], m.index[2*i+1]
+}
+
+// Submatches returns the texts of the whole match and all subexpressions, as
+// regexp.Regexp.FindStringSubmatch does.
+func (m Match[S]) Submatches() []S {
+	ret := make([]S, m.NumSubmatch()+1)
+	for i := range ret {
+		ret[i] = m.Submatch(i)
+	}
+	return ret
+}
+
+func findAllSubmatchIndex[S string | []byte](re *regexp.Regexp, s S) [][]int {
+	if v, ok := any(s).(string); ok {
+		return re.FindAllStringSubmatchIndex(v, -1)
+	}
+	return re.FindAllSubmatchIndex(any(s).([]byte), -1)
+}
+
+// MatchIterator is a forward iterator over the successive non-overlapping
+// matches of a regexp in a string or []byte, like C++ std::regex_iterator.
+//
+// Match positions are located when the first iterator is created, by
+// regexp.Regexp.FindAllStringSubmatchIndex or FindAllSubmatchIndex. Match
+// texts are sliced from the source lazily.
+//
+// Matches are not searched lazily because package regexp cannot resume a
+// search at an offset: matching s[i:] loses the text before i, which changes
+// the result of ^, \b, and \B. The iterators hold 2*(re.NumSubexp()+1) ints
+// per match.
+type MatchIterator[S string | []byte] struct {
+	src     S
+	matches [][]int
+	i       int
+}
+
+// MatchBegin returns an iterator to the first match of re in s. It finds all
+// the matches in s up front, in the same way as FindAllStringSubmatchIndex.
+func MatchBegin[S string | []byte](re *regexp.Regexp, s S) MatchIterator[S] {
+	matches := findAllSubmatchIndex(re, s)
+	if len(matches) == 0 {
+		return MatchEnd(s)
+	}
+	return MatchIterator[S]{src: s, matches: matches}
+}
+
+// MatchEnd returns an iterator to the passed last match in s.
+func MatchEnd[S string | []byte](s S) MatchIterator[S] {
+	return MatchIterator[S]{src: s, i: -1}
+}
+
+func (it MatchIterator[S]) String() string {
+	if it.i < 0 {
+		return "match@end"
+	}
+	return fmt.Sprintf("match%v@%d", it.matches[it.i][:2], it.i)
+}
+
+func (it MatchIterator[S]) Read() Match[S] {
+	return Match[S]{src: it.src, index: it.matches[it.i]}
+}
+
+func (it MatchIterator[S]) Eq(it2 MatchIterator[S]) bool {
+	return it.i == it2.i
+}
+
+func (it MatchIterator[S]) AllowMultiplePass() {}
+
+func (it MatchIterator[S]) Next() MatchIterator[S] {
+	if it.i+1 >= len(it.matches) {
+		return MatchEnd(it.src)
+	}
+	it.i++
+	return it
+}
+
+// RegexpTokenIterator is a forward iterator over the pieces of a string or
+// []byte selected by regexp matches, like C++ std::regex_token_iterator.
+//
+// Token positions are located when the first iterator is created, for the same
+// reason as MatchIterator. The iterators hold 2 ints per token.
+type RegexpTokenIterator[S string | []byte] struct {
+	src    S
+	bounds []int
+	i      int
+}
+
+// RegexpTokenBegin returns an iterator to the first token of s. It finds all
+// the matches in s up front.
+//
+// If group is -1, the tokens are the pieces between matches of re, the same as
+// the ones returned by regexp.Regexp.Split with n < 0. Otherwise the tokens are
+// the texts of the given subexpression of every match, where 0 is the whole
+// match; the token is empty if the subexpression did not participate. It
+// panics if group is not in [-1, re.NumSubexp()].
+func RegexpTokenBegin[S string | []byte](re *regexp.Regexp, s S, group int) RegexpTokenIterator[S] {
+	if group < -1 || group > re.NumSubexp() {
+		panic(fmt.Sprintf("strs: group %d out of range [-1, %d]", group, re.NumSubexp()))
+	}
+	var bounds []int
+	if group < 0 {
+		bounds = splitBounds(re, s)
+	} else {
+		for _, m := range findAllSubmatchIndex(re, s) {
+			bounds = append(bounds, m[2*group], m[2*group+1])
+		}
+	}
+	if len(bounds) == 0 {
+		return RegexpTokenEnd(s)
+	}
+	return RegexpTokenIterator[S]{src: s, bounds: bounds}
+}
+
+// RegexpTokenEnd returns an iterator to the passed last token of s.
+func RegexpTokenEnd[S string | []byte](s S) RegexpTokenIterator[S] {
+	return RegexpTokenIterator[S]{src: s, i: -1}
+}
+
+func splitBounds[S string | []byte](re *regexp.Regexp, s S) []int {
+	if len(s) == 0 {
+		if re.String() == "" {
+			return nil
+		}
+		return []int{0, 0}
+	}
+	var bounds []int
+	beg, end := 0, 0
+	for _, m := range findAllSubmatchIndex(re, s) {
+		end = m[0]
+		if m[1] != 0 {
+			bounds = append(bounds, beg, end)
+		}
+		beg = m[1]
+	}
+	if end != len(s) {
+		bounds = append(bounds, beg, len(s))
+	}
+	return bounds
+}
+
+func (it RegexpTokenIterator[S]) String() string {
+	if it.i < 0 {
+		return "token@end"
+	}
+	return fmt.Sprintf("token%v@%d", it.bounds[2*it.i:2*it.i+2], it.i)
+}
+
+// Offset returns the byte offset of the current token in the source, or -1 if
+// the token comes from a subexpression that did not participate in the match.
+func (it RegexpTokenIterator[S]) Offset() int {
+	return it.bounds[2*it.i]
+}
+
+func (it RegexpTokenIterator[S]) Read() S {
+	start, end := it.bounds[2*it.i], it.bounds[2*it.i+1]
+	if start < 0 {
+		var zero S
+		return zero
+	}
+	return it.src[start:end]
+}
+
+func (it RegexpTokenIterator[S]) Eq(it2 RegexpTokenIterator[S]) bool {
+	return it.i == it2.i
+}
+
+func (it RegexpTokenIterator[S]) AllowMultiplePass() {}
+
+func (it RegexpTokenIterator[S]) Next() RegexpTokenIterator[S] {
+	if 2*(it.i+1) >= len(it.bounds) {
+		return RegexpTokenEnd(it.src)
+	}
+	it.i++
+	return it
+}
@@ -0,0 +1,128 @@
+package strs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	. "github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.ForwardReader[Match[string], MatchIterator[string]] = MatchIterator[string]{}
+	_ iter.ForwardReader[[]byte, RegexpTokenIterator[[]byte]]  = RegexpTokenIterator[[]byte]{}
+)
+
+func TestMatchIterator(t *testing.T) {
+	assert := assert.New(t)
+	re := regexp.MustCompile(`(\w+)=(\d+)?`)
+	s := "a=1 b= c=33"
+
+	it := MatchBegin(re, s)
+	it.AllowMultiplePass()
+	assert.Contains(fmt.Sprint(it), "match[0 3]")
+	assert.Equal("match@end", fmt.Sprint(MatchEnd(s)))
+
+	m := it.Read()
+	assert.Equal("a=1", m.Text())
+	assert.Equal(0, m.Start())
+	assert.Equal(3, m.End())
+	assert.Equal(2, m.NumSubmatch())
+	assert.Equal([]string{"a=1", "a", "1"}, m.Submatches())
+
+	m = it.Next().Read()
+	assert.Equal("b=", m.Text())
+	assert.Equal("", m.Submatch(2))
+	start, end := m.SubmatchIndex(2)
+	assert.Equal([]int{-1, -1}, []int{start, end})
+
+	assert.Equal(3, iter.Distance[Match[string]](it, MatchEnd(s)))
+	assert.True(MatchBegin(re, "none").Eq(MatchEnd("none")))
+
+	var texts []string
+	algo.Transform(MatchBegin(re, s), MatchEnd(s), slices.Appender(&texts), Match[string].Text)
+	assert.Equal(re.FindAllString(s, -1), texts)
+}
+
+func TestMatchIteratorBytes(t *testing.T) {
+	assert := assert.New(t)
+	re := regexp.MustCompile(`a*`)
+	b := []byte("baaab")
+
+	var idx [][]int
+	algo.Transform(MatchBegin(re, b), MatchEnd(b), slices.Appender(&idx), func(m Match[[]byte]) []int {
+		return []int{m.Start(), m.End()}
+	})
+	assert.Equal(re.FindAllIndex(b, -1), idx)
+}
+
+func TestMatchIteratorContext(t *testing.T) {
+	assert := assert.New(t)
+	// assertions see the text before the previous match
+	for _, expr := range []string{`^a`, `\bx`, `\Bx`, `(?m)^\w`} {
+		re := regexp.MustCompile(expr)
+		s := "aa xx\nxa"
+		var idx [][]int
+		algo.Transform(MatchBegin(re, s), MatchEnd(s), slices.Appender(&idx), func(m Match[string]) []int {
+			return []int{m.Start(), m.End()}
+		})
+		assert.Equal(re.FindAllStringIndex(s, -1), idx, expr)
+	}
+}
+
+func TestRegexpTokenIterator(t *testing.T) {
+	assert := assert.New(t)
+	for _, c := range []struct{ expr, s string }{
+		{`,\s*`, "a, b,c,  d"},
+		{`,`, ",a,,b,"},
+		{`x*`, "axbxxc"},
+		{`,`, ""},
+		{``, ""},
+		{``, "abc"},
+	} {
+		re := regexp.MustCompile(c.expr)
+		got := []string{}
+		algo.Copy[string](RegexpTokenBegin(re, c.s, -1), RegexpTokenEnd(c.s), slices.Appender(&got))
+		assert.Equal(re.Split(c.s, -1), got, "%q %q", c.expr, c.s)
+	}
+
+	re := regexp.MustCompile(`(\w+)@(\w+)?`)
+	s := "x@y, z@, w@v"
+	var users, hosts []string
+	algo.Copy[string](RegexpTokenBegin(re, s, 1), RegexpTokenEnd(s), slices.Appender(&users))
+	algo.Copy[string](RegexpTokenBegin(re, s, 2), RegexpTokenEnd(s), slices.Appender(&hosts))
+	assert.Equal([]string{"x", "z", "w"}, users)
+	assert.Equal([]string{"y", "", "v"}, hosts)
+	assert.PanicsWithValue("strs: group 3 out of range [-1, 2]", func() { RegexpTokenBegin(re, s, 3) })
+	assert.PanicsWithValue("strs: group 3 out of range [-1, 2]", func() { RegexpTokenBegin(re, "none", 3) })
+	assert.PanicsWithValue("strs: group -2 out of range [-1, 2]", func() { RegexpTokenBegin(re, s, -2) })
+
+	it := RegexpTokenBegin(re, s, 2)
+	it.AllowMultiplePass()
+	assert.Equal(2, it.Offset())
+	assert.Equal(-1, it.Next().Offset())
+	assert.Contains(fmt.Sprint(it), "token[2 3]")
+	assert.Equal("token@end", fmt.Sprint(RegexpTokenEnd(s)))
+
+	b := []byte("k1=v1;k2=v2")
+	assert.Equal(2, algo.CountIf(RegexpTokenBegin(regexp.MustCompile(`;`), b, -1), RegexpTokenEnd(b), func(x []byte) bool {
+		return len(x) == 5
+	}))
+}
+
+func TestRegexpLogScan(t *testing.T) {
+	assert := assert.New(t)
+	re := regexp.MustCompile(`(?m)^(\w+) `)
+	log := "INFO start\nWARN disk\nERROR boom\nINFO done\n"
+
+	assert.Equal(2, algo.CountIf(MatchBegin(re, log), MatchEnd(log), func(m Match[string]) bool {
+		return m.Submatch(1) == "INFO"
+	}))
+	sb := StringBuilderInserter[string]{Delimiter: ","}
+	algo.Copy[string](RegexpTokenBegin(re, log, 1), RegexpTokenEnd(log), &sb)
+	assert.Equal("INFO,WARN,ERROR,INFO", sb.String())
+}
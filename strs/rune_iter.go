@@ -0,0 +1,113 @@
+package strs
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// RuneIterator is a bidirectional iterator over the runes of a string. It
+// decodes UTF-8 in place instead of converting the string to []rune.
+//
+// Invalid UTF-8 bytes are read one at a time as utf8.RuneError, as in a for
+// range loop.
+type RuneIterator struct {
+	s    string
+	i    int
+	step int
+}
+
+// RuneBegin returns an iterator to the first rune of the string.
+func RuneBegin(s string) RuneIterator {
+	return RuneIterator{
+		s:    s,
+		step: 1,
+	}
+}
+
+// RuneEnd returns an iterator to the passed last rune of the string.
+func RuneEnd(s string) RuneIterator {
+	return RuneIterator{
+		s:    s,
+		i:    len(s),
+		step: 1,
+	}
+}
+
+// RuneRBegin returns an iterator to the last rune of the string.
+func RuneRBegin(s string) RuneIterator {
+	return RuneIterator{
+		s:    s,
+		i:    len(s),
+		step: -1,
+	}.backward()
+}
+
+// RuneREnd returns an iterator to the passed first rune of the string.
+func RuneREnd(s string) RuneIterator {
+	return RuneIterator{
+		s:    s,
+		i:    -1,
+		step: -1,
+	}
+}
+
+func (it RuneIterator) String() string {
+	dir := "->"
+	if it.step < 0 {
+		dir = "<-"
+	}
+	return fmt.Sprintf("%s@%d%s", it.s, it.i, dir)
+}
+
+// Offset returns the byte offset of the current rune in the string. It
+// returns len(s) for RuneEnd and -1 for RuneREnd.
+func (it RuneIterator) Offset() int {
+	return it.i
+}
+
+func (it RuneIterator) Read() rune {
+	r, _ := utf8.DecodeRuneInString(it.s[it.i:])
+	return r
+}
+
+func (it RuneIterator) Eq(it2 RuneIterator) bool {
+	return it.i == it2.i
+}
+
+func (it RuneIterator) AllowMultiplePass() {}
+
+func (it RuneIterator) Next() RuneIterator {
+	if it.step < 0 {
+		return it.backward()
+	}
+	return it.forward()
+}
+
+func (it RuneIterator) Prev() RuneIterator {
+	if it.step < 0 {
+		return it.forward()
+	}
+	return it.backward()
+}
+
+// forward moves to the next rune in string order.
+func (it RuneIterator) forward() RuneIterator {
+	if it.i < 0 {
+		it.i = 0
+		return it
+	}
+	_, size := utf8.DecodeRuneInString(it.s[it.i:])
+	it.i += size
+	return it
+}
+
+// backward moves to the previous rune in string order.
+func (it RuneIterator) backward() RuneIterator {
+	if it.i == 0 {
+		it.i = -1
+		return it
+	}
+	_, size := utf8.DecodeLastRuneInString(it.s[:it.i])
+	it.i -= size
+	return it
+}
@@ -0,0 +1,74 @@
+package strs_test
+
+import (
+	"fmt"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	. "github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ iter.BidiReader[rune, RuneIterator] = RuneIterator{}
+
+func TestRuneIterator(t *testing.T) {
+	assert := assert.New(t)
+	s := "a香b港c"
+
+	RuneBegin(s).AllowMultiplePass()
+	assert.Contains(fmt.Sprint(RuneBegin(s)), "->")
+	assert.Contains(fmt.Sprint(RuneRBegin(s)), "<-")
+
+	assert.Equal(MakeString[rune](RuneBegin(s), RuneEnd(s)), s)
+	assert.Equal(MakeString[rune](RuneRBegin(s), RuneREnd(s)), "c港b香a")
+	assert.Equal(iter.Distance[rune](RuneBegin(s), RuneEnd(s)), utf8.RuneCountInString(s))
+	assert.Equal(iter.Distance[rune](RuneRBegin(s), RuneREnd(s)), utf8.RuneCountInString(s))
+
+	it := RuneBegin(s).Next()
+	assert.Equal(it.Read(), '香')
+	assert.Equal(it.Offset(), 1)
+	assert.Equal(it.Next().Offset(), 4)
+	assert.True(it.Next().Prev().Eq(it))
+	assert.Equal(RuneEnd(s).Prev().Read(), 'c')
+	assert.Equal(RuneEnd(s).Offset(), len(s))
+
+	rit := RuneRBegin(s).Next()
+	assert.Equal(rit.Read(), '港')
+	assert.Equal(rit.Offset(), 5)
+	assert.True(rit.Prev().Eq(RuneRBegin(s)))
+	assert.Equal(RuneREnd(s).Prev().Read(), 'a')
+	assert.Equal(RuneREnd(s).Offset(), -1)
+
+	assert.True(RuneBegin("").Eq(RuneEnd("")))
+	assert.True(RuneRBegin("").Eq(RuneREnd("")))
+}
+
+func TestRuneIteratorInvalidUTF8(t *testing.T) {
+	assert := assert.New(t)
+	s := "a\xffb\xe4\xb8"
+
+	var fwd, bwd []rune
+	algo.Copy[rune](RuneBegin(s), RuneEnd(s), slices.Appender(&fwd))
+	algo.Copy[rune](RuneRBegin(s), RuneREnd(s), slices.Appender(&bwd))
+	assert.Equal(fwd, []rune(s))
+	slices.Reverse(bwd)
+	assert.Equal(bwd, fwd)
+}
+
+func TestRuneIteratorAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	s := "春眠不觉晓，处处闻啼鸟。"
+
+	assert.Equal(algo.Count(RuneBegin(s), RuneEnd(s), '处'), 2)
+
+	sub := "闻啼"
+	it := algo.Search[rune](RuneBegin(s), RuneEnd(s), RuneBegin(sub), RuneEnd(sub))
+	assert.Equal(it.Offset(), len("春眠不觉晓，处处"))
+
+	var sb StringBuilderInserter[rune]
+	algo.ReverseCopy[rune](RuneBegin(s), RuneEnd(s), &sb)
+	assert.Equal(sb.String(), "。鸟啼闻处处，晓觉不眠春")
+}
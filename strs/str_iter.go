@@ -7,8 +7,8 @@ import (
 	"github.com/disksing/iter/v2"
 )
 
-// Iterator accesses the bytes in a string. To traverse runes, convert the
-// string to []rune and use slices.Iterator.
+// Iterator accesses the bytes in a string. To traverse runes, use
+// RuneIterator.
 type Iterator struct {
 	s    string
 	i    int
@@ -120,7 +120,7 @@ func (si *StringBuilderInserter[T]) Write(x T) {
 }
 
 // MakeString creates a string from the range specified by [first, last). The value
-// type should be byte or rune.
+// type should be byte or rune, such as the values of Iterator or RuneIterator.
 func MakeString[T byte | rune, It iter.ForwardReader[T, It]](first, last It) string {
 	var s strings.Builder
 	for ; !first.Eq(last); first = first.Next() {
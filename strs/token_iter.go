@@ -0,0 +1,193 @@
+package strs
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// scanner finds the token that starts scanning at offset at. It returns the
+// token and the offset to resume scanning from, which must be greater than at.
+type scanner func(s string, at int) (tok string, next int, ok bool)
+
+// TokenIterator is a forward iterator that yields the tokens of a string
+// lazily, without building the whole token list.
+//
+// Use TokenEnd as the end of any token range.
+type TokenIterator struct {
+	s    string
+	tok  string
+	pos  int
+	next int
+	scan scanner
+}
+
+func makeTokenIterator(s string, scan scanner) TokenIterator {
+	return TokenIterator{s: s, scan: scan}.scanFrom(0)
+}
+
+// TokenEnd returns an iterator to the passed last token of the string.
+func TokenEnd(s string) TokenIterator {
+	return TokenIterator{s: s, pos: -1}
+}
+
+// SplitBegin returns an iterator to the first substring of s separated by
+// sep. The tokens are the same as the ones returned by strings.Split.
+func SplitBegin(s, sep string) TokenIterator {
+	return makeTokenIterator(s, splitScanner(sep, 0))
+}
+
+// SplitAfterBegin returns an iterator to the first substring of s after each
+// instance of sep. The tokens are the same as the ones returned by
+// strings.SplitAfter.
+func SplitAfterBegin(s, sep string) TokenIterator {
+	return makeTokenIterator(s, splitScanner(sep, len(sep)))
+}
+
+// FieldsBegin returns an iterator to the first field of s, where fields are
+// separated by white space as defined by unicode.IsSpace.
+func FieldsBegin(s string) TokenIterator {
+	return FieldsFuncBegin(s, unicode.IsSpace)
+}
+
+// FieldsFuncBegin returns an iterator to the first field of s, where fields
+// are separated by runs of runes satisfying f. The tokens are the same as the
+// ones returned by strings.FieldsFunc.
+func FieldsFuncBegin(s string, f func(rune) bool) TokenIterator {
+	return makeTokenIterator(s, fieldsScanner(f))
+}
+
+// LinesBegin returns an iterator to the first line of s. Line terminators
+// "\n" and "\r\n" are stripped, as by bufio.ScanLines.
+func LinesBegin(s string) TokenIterator {
+	return makeTokenIterator(s, linesScanner(false))
+}
+
+// LinesAfterBegin returns an iterator to the first line of s. Line
+// terminators are kept, as by strings.Lines.
+func LinesAfterBegin(s string) TokenIterator {
+	return makeTokenIterator(s, linesScanner(true))
+}
+
+// ScanBegin returns an iterator to the first token of s produced by the split
+// function, which is called as by bufio.Scanner at EOF.
+//
+// Next panics if split returns an error other than bufio.ErrFinalToken, or if
+// it returns a token without advancing.
+func ScanBegin(s string, split bufio.SplitFunc) TokenIterator {
+	return makeTokenIterator(s, splitFuncScanner(s, split))
+}
+
+func (it TokenIterator) scanFrom(at int) TokenIterator {
+	tok, next, ok := it.scan(it.s, at)
+	if !ok {
+		return TokenEnd(it.s)
+	}
+	it.tok, it.pos, it.next = tok, at, next
+	return it
+}
+
+func (it TokenIterator) String() string {
+	return fmt.Sprintf("%q@%d", it.tok, it.pos)
+}
+
+func (it TokenIterator) Read() string {
+	return it.tok
+}
+
+func (it TokenIterator) Eq(it2 TokenIterator) bool {
+	return it.pos == it2.pos
+}
+
+func (it TokenIterator) AllowMultiplePass() {}
+
+func (it TokenIterator) Next() TokenIterator {
+	return it.scanFrom(it.next)
+}
+
+func splitScanner(sep string, keep int) scanner {
+	return func(s string, at int) (string, int, bool) {
+		if sep == "" {
+			if at >= len(s) {
+				return "", 0, false
+			}
+			_, size := utf8.DecodeRuneInString(s[at:])
+			return s[at : at+size], at + size, true
+		}
+		if at > len(s) {
+			return "", 0, false
+		}
+		if i := strings.Index(s[at:], sep); i >= 0 {
+			return s[at : at+i+keep], at + i + len(sep), true
+		}
+		return s[at:], len(s) + 1, true
+	}
+}
+
+func fieldsScanner(f func(rune) bool) scanner {
+	return func(s string, at int) (string, int, bool) {
+		start := strings.IndexFunc(s[at:], func(r rune) bool { return !f(r) })
+		if start < 0 {
+			return "", 0, false
+		}
+		start += at
+		end := strings.IndexFunc(s[start:], f)
+		if end < 0 {
+			return s[start:], len(s), true
+		}
+		end += start
+		return s[start:end], end, true
+	}
+}
+
+func linesScanner(keepTerminator bool) scanner {
+	return func(s string, at int) (string, int, bool) {
+		if at >= len(s) {
+			return "", 0, false
+		}
+		next := len(s)
+		if i := strings.IndexByte(s[at:], '\n'); i >= 0 {
+			next = at + i + 1
+		}
+		line := s[at:next]
+		if !keepTerminator {
+			line = strings.TrimSuffix(line, "\n")
+			line = strings.TrimSuffix(line, "\r")
+		}
+		return line, next, true
+	}
+}
+
+func splitFuncScanner(s string, split bufio.SplitFunc) scanner {
+	data := []byte(s)
+	return func(_ string, at int) (string, int, bool) {
+		for at < len(data) {
+			advance, token, err := split(data[at:], true)
+			if err != nil && err != bufio.ErrFinalToken {
+				panic(err)
+			}
+			if advance < 0 || at+advance > len(data) {
+				panic(bufio.ErrBadReadCount)
+			}
+			if err == bufio.ErrFinalToken {
+				if token == nil {
+					return "", 0, false
+				}
+				return string(token), len(data) + 1, true
+			}
+			if token != nil {
+				if advance == 0 {
+					panic("strs: split function returned a token without advancing")
+				}
+				return string(token), at + advance, true
+			}
+			if advance == 0 {
+				return "", 0, false
+			}
+			at += advance
+		}
+		return "", 0, false
+	}
+}
@@ -0,0 +1,121 @@
+package strs_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	. "github.com/disksing/iter/v2/strs"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ iter.ForwardReader[string, TokenIterator] = TokenIterator{}
+
+func tokens(first TokenIterator, s string) []string {
+	ret := []string{}
+	algo.Copy[string](first, TokenEnd(s), slices.Appender(&ret))
+	return ret
+}
+
+func TestSplitIterator(t *testing.T) {
+	assert := assert.New(t)
+	for _, c := range []struct{ s, sep string }{
+		{"a,b,c", ","},
+		{"a,b,c,", ","},
+		{",a,,b", ","},
+		{"", ","},
+		{"abc", ""},
+		{"", ""},
+		{"a香b", ""},
+		{"a--b--", "--"},
+		{"abc", "x"},
+	} {
+		assert.Equal(strings.Split(c.s, c.sep), tokens(SplitBegin(c.s, c.sep), c.s), "%q %q", c.s, c.sep)
+		assert.Equal(strings.SplitAfter(c.s, c.sep), tokens(SplitAfterBegin(c.s, c.sep), c.s), "%q %q", c.s, c.sep)
+	}
+
+	s := "a,b"
+	it := SplitBegin(s, ",")
+	it.AllowMultiplePass()
+	assert.Contains(fmt.Sprint(it), `"a"@0`)
+	assert.False(it.Eq(TokenEnd(s)))
+	assert.True(it.Next().Next().Eq(TokenEnd(s)))
+}
+
+func TestFieldsIterator(t *testing.T) {
+	assert := assert.New(t)
+	for _, s := range []string{"", "   ", "a", "  a  b c ", "a\tb\nc"} {
+		assert.Equal(strings.Fields(s), tokens(FieldsBegin(s), s), "%q", s)
+	}
+	f := func(r rune) bool { return r == ';' || unicode.IsDigit(r) }
+	s := ";;x1y;;z9"
+	assert.Equal(strings.FieldsFunc(s, f), tokens(FieldsFuncBegin(s, f), s))
+}
+
+func TestLinesIterator(t *testing.T) {
+	assert := assert.New(t)
+	for _, s := range []string{"", "a", "a\n", "a\r\nb\n\nc", "\n\n"} {
+		want := []string{}
+		sc := bufio.NewScanner(strings.NewReader(s))
+		for sc.Scan() {
+			want = append(want, sc.Text())
+		}
+		assert.Equal(want, tokens(LinesBegin(s), s), "%q", s)
+
+		want = want[:0]
+		for l := range strings.Lines(s) {
+			want = append(want, l)
+		}
+		assert.Equal(want, tokens(LinesAfterBegin(s), s), "%q", s)
+	}
+}
+
+func TestScanIterator(t *testing.T) {
+	assert := assert.New(t)
+	s := " one two\tthree\n"
+	assert.Equal([]string{"one", "two", "three"}, tokens(ScanBegin(s, bufio.ScanWords), s))
+	assert.Equal([]string{"a", "香"}, tokens(ScanBegin("a香", bufio.ScanRunes), "a香"))
+
+	final := func(data []byte, atEOF bool) (int, []byte, error) {
+		if data[0] == '!' {
+			return 0, []byte("stop"), bufio.ErrFinalToken
+		}
+		return 1, data[:1], nil
+	}
+	assert.Equal([]string{"a", "b", "stop"}, tokens(ScanBegin("ab!cd", final), "ab!cd"))
+
+	fail := func(data []byte, atEOF bool) (int, []byte, error) {
+		return 0, nil, errors.New("fail")
+	}
+	assert.Panics(func() { ScanBegin("x", fail) })
+	stuck := func(data []byte, atEOF bool) (int, []byte, error) {
+		return 0, data, nil
+	}
+	assert.Panics(func() { ScanBegin("x", stuck) })
+}
+
+func TestTokenIteratorAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	log := "INFO start\nWARN disk\nINFO ok\nERROR boom\nINFO done\n"
+
+	assert.Equal(3, algo.CountIf(LinesBegin(log), TokenEnd(log), func(l string) bool {
+		return strings.HasPrefix(l, "INFO")
+	}))
+	it := algo.FindIf(LinesBegin(log), TokenEnd(log), func(l string) bool {
+		return strings.HasPrefix(l, "ERROR")
+	})
+	assert.Equal("ERROR boom", it.Read())
+
+	csv := "a,b,,c"
+	assert.Equal(1, algo.Count(SplitBegin(csv, ","), TokenEnd(csv), ""))
+
+	sb := StringBuilderInserter[string]{Delimiter: "|"}
+	algo.Copy[string](FieldsBegin(" x  y z "), TokenEnd(" x  y z "), &sb)
+	assert.Equal("x|y|z", sb.String())
+}
@@ -0,0 +1,13 @@
+// Package traverse provides iterators that visit the nodes of user-defined
+// trees and graphs.
+//
+// Nodes can be of any type. The structure is described by accessor functions:
+// children for trees, and neighbors plus a comparable key for graphs, which
+// may have cycles, so algorithms such as algo.FindIf, algo.CountIf, and
+// algo.Copy work on hierarchical data.
+//
+// Preorder and postorder iterators are forward iterators. They share the
+// stack of pending nodes, so copying one is O(1) and nodes already visited are
+// not retained. Level order and graph iterators are single-pass input
+// iterators that only hold the frontier of the search.
+package traverse
@@ -0,0 +1,233 @@
+package traverse
+
+// frame is an element of an immutable stack. Stacks share their tails, so
+// pushing and popping take O(1) time and never modify frames reachable from
+// other iterators.
+type frame[N any] struct {
+	n    N
+	c    []N // children of n, for postorder
+	k    int // index of the child of n being visited, for postorder
+	next *frame[N]
+}
+
+// tree identifies a depth-first traversal of a tree.
+type tree[N any] struct {
+	children func(N) []N
+	post     bool
+}
+
+// Iterator is a forward iterator over the nodes of a preorder or postorder
+// traversal of a tree. Nodes are visited lazily as the iterator advances.
+// An iterator only holds the nodes that are yet to be visited, so nodes
+// already passed by all iterators of the traversal can be collected.
+type Iterator[N any] struct {
+	t   *tree[N]
+	top *frame[N]
+	i   int
+}
+
+// End returns an iterator to the passed last node of any tree traversal.
+func End[N any]() Iterator[N] {
+	return Iterator[N]{}
+}
+
+func (it Iterator[N]) Eq(x Iterator[N]) bool {
+	if e1, e2 := it.top == nil, x.top == nil; e1 || e2 {
+		return e1 && e2
+	}
+	return it.t == x.t && it.i == x.i
+}
+
+func (it Iterator[N]) AllowMultiplePass() {}
+
+func (it Iterator[N]) Next() Iterator[N] {
+	if it.t.post {
+		it.top = it.t.popPost(it.top)
+	} else {
+		it.top = it.t.popPre(it.top)
+	}
+	it.i++
+	return it
+}
+
+func (it Iterator[N]) Read() N {
+	return it.top.n
+}
+
+// popPre replaces the top node with its children.
+func (t *tree[N]) popPre(top *frame[N]) *frame[N] {
+	rest := top.next
+	c := t.children(top.n)
+	for i := len(c) - 1; i >= 0; i-- {
+		rest = &frame[N]{n: c[i], next: rest}
+	}
+	return rest
+}
+
+// descend pushes the first descendants of top until reaching a leaf.
+func (t *tree[N]) descend(top *frame[N]) *frame[N] {
+	for top.k < len(top.c) {
+		n := top.c[top.k]
+		top = &frame[N]{n: n, c: t.children(n), next: top}
+	}
+	return top
+}
+
+// popPost removes the top node and moves to the next child of its parent.
+func (t *tree[N]) popPost(top *frame[N]) *frame[N] {
+	p := top.next
+	if p == nil {
+		return nil
+	}
+	return t.descend(&frame[N]{n: p.n, c: p.c, k: p.k + 1, next: p.next})
+}
+
+// PreorderBegin returns an iterator to the first node of the preorder
+// traversal of the tree rooted at root. A node is visited before its children,
+// which are visited in the order returned by children.
+//
+// The iterator holds the unvisited siblings of the nodes on the path from root,
+// which is O(depth × fanout) nodes. Copying it takes O(1) time, and children is
+// called again for each node an iterator copy advances past.
+func PreorderBegin[N any](root N, children func(N) []N) Iterator[N] {
+	return Iterator[N]{t: &tree[N]{children: children}, top: &frame[N]{n: root}}
+}
+
+// PostorderBegin returns an iterator to the first node of the postorder
+// traversal of the tree rooted at root. A node is visited after its children,
+// which are visited in the order returned by children.
+//
+// The iterator holds the path from root and the children of the nodes on it,
+// which is O(depth × fanout) nodes. Copying it takes O(1) time, and children is
+// called again for each node an iterator copy descends into.
+func PostorderBegin[N any](root N, children func(N) []N) Iterator[N] {
+	t := &tree[N]{children: children, post: true}
+	return Iterator[N]{t: t, top: t.descend(&frame[N]{n: root, c: children(root)})}
+}
+
+// SearchIterator is a single-pass input iterator over the nodes of a level
+// order or graph traversal. It only holds the frontier of the search, plus the
+// keys of the visited nodes for graphs. A nil *SearchIterator is its end
+// sentinel.
+type SearchIterator[N any] struct {
+	cur  N
+	next func() (N, bool)
+	done bool
+}
+
+func search[N any](next func() (N, bool)) *SearchIterator[N] {
+	return (&SearchIterator[N]{next: next}).Next()
+}
+
+// SearchEnd returns an iterator to the passed last node of any level order or
+// graph traversal.
+func SearchEnd[N any]() *SearchIterator[N] {
+	return nil
+}
+
+func (it *SearchIterator[N]) atEnd() bool {
+	return it == nil || it.done
+}
+
+func (it *SearchIterator[N]) Eq(x *SearchIterator[N]) bool {
+	if e1, e2 := it.atEnd(), x.atEnd(); e1 || e2 {
+		return e1 && e2
+	}
+	return it == x
+}
+
+func (it *SearchIterator[N]) Next() *SearchIterator[N] {
+	var ok bool
+	it.cur, ok = it.next()
+	it.done = !ok
+	return it
+}
+
+func (it *SearchIterator[N]) Read() N {
+	return it.cur
+}
+
+// queue is a FIFO queue that releases popped elements.
+type queue[N any] []N
+
+func (q *queue[N]) pop() N {
+	var zero N
+	n := (*q)[0]
+	(*q)[0], *q = zero, (*q)[1:]
+	return n
+}
+
+// LevelOrderBegin returns an iterator to the first node of the level order
+// (breadth-first) traversal of the tree rooted at root.
+//
+// The iterator holds the unvisited nodes of the current and the next level,
+// which is O(width) nodes.
+func LevelOrderBegin[N any](root N, children func(N) []N) *SearchIterator[N] {
+	q := queue[N]{root}
+	return search(func() (N, bool) {
+		var n N
+		if len(q) == 0 {
+			return n, false
+		}
+		n = q.pop()
+		q = append(q, children(n)...)
+		return n, true
+	})
+}
+
+// DFSBegin returns an iterator to the first node of the depth-first traversal
+// of the graph reachable from start. Nodes are visited in the same order as a
+// recursive search that follows neighbors in the order returned by neighbors.
+// Nodes with the same key are visited once.
+//
+// The iterator holds the unvisited neighbors of the nodes on the search path,
+// and the keys of all visited nodes.
+func DFSBegin[N any, K comparable](start N, neighbors func(N) []N, key func(N) K) *SearchIterator[N] {
+	stack := []N{start}
+	visited := make(map[K]struct{})
+	return search(func() (N, bool) {
+		var n, zero N
+		for len(stack) > 0 {
+			top := len(stack) - 1
+			n, stack[top] = stack[top], zero
+			stack = stack[:top]
+			if _, ok := visited[key(n)]; ok {
+				continue
+			}
+			visited[key(n)] = struct{}{}
+			c := neighbors(n)
+			for i := len(c) - 1; i >= 0; i-- {
+				if _, ok := visited[key(c[i])]; !ok {
+					stack = append(stack, c[i])
+				}
+			}
+			return n, true
+		}
+		return n, false
+	})
+}
+
+// BFSBegin returns an iterator to the first node of the breadth-first
+// traversal of the graph reachable from start. Nodes with the same key are
+// visited once.
+//
+// The iterator holds the discovered but unvisited nodes, and the keys of all
+// discovered nodes.
+func BFSBegin[N any, K comparable](start N, neighbors func(N) []N, key func(N) K) *SearchIterator[N] {
+	q := queue[N]{start}
+	visited := map[K]struct{}{key(start): {}}
+	return search(func() (N, bool) {
+		var n N
+		if len(q) == 0 {
+			return n, false
+		}
+		n = q.pop()
+		for _, c := range neighbors(n) {
+			if _, ok := visited[key(c)]; !ok {
+				visited[key(c)] = struct{}{}
+				q = append(q, c)
+			}
+		}
+		return n, true
+	})
+}
@@ -0,0 +1,111 @@
+package traverse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	. "github.com/disksing/iter/v2/traverse"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.ForwardReader[int, Iterator[int]]    = Iterator[int]{}
+	_ iter.InputIter[int, *SearchIterator[int]] = (*SearchIterator[int])(nil)
+)
+
+type tree struct {
+	name     string
+	children []*tree
+}
+
+func leaf(name string) *tree { return &tree{name: name} }
+
+func children(t *tree) []*tree { return t.children }
+
+// root is the tree a(b(e, f), c, d(g)).
+var root = &tree{"a", []*tree{
+	{"b", []*tree{leaf("e"), leaf("f")}},
+	leaf("c"),
+	{"d", []*tree{leaf("g")}},
+}}
+
+func name(t *tree) string { return t.name }
+
+func names(first Iterator[*tree]) string {
+	var s []string
+	algo.Transform(first, End[*tree](), slices.Appender(&s), name)
+	return strings.Join(s, "")
+}
+
+func searchNames(first *SearchIterator[*tree]) string {
+	var s []string
+	algo.Transform(first, SearchEnd[*tree](), slices.Appender(&s), name)
+	return strings.Join(s, "")
+}
+
+func TestTree(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("abefcdg", names(PreorderBegin(root, children)))
+	assert.Equal("efbcgda", names(PostorderBegin(root, children)))
+	assert.Equal("abcdefg", searchNames(LevelOrderBegin(root, children)))
+	assert.Equal("e", names(PreorderBegin(leaf("e"), children)))
+
+	isLeaf := func(t *tree) bool { return len(t.children) == 0 }
+	assert.Equal(4, algo.CountIf(PreorderBegin(root, children), End[*tree](), isLeaf))
+	it := algo.FindIf(LevelOrderBegin(root, children), SearchEnd[*tree](), isLeaf)
+	assert.Equal("c", it.Read().name)
+	assert.True(algo.FindIf(PreorderBegin(root, children), End[*tree](), func(t *tree) bool {
+		return t.name == "z"
+	}).Eq(End[*tree]()))
+}
+
+func TestMultiplePass(t *testing.T) {
+	assert := assert.New(t)
+	first := PreorderBegin(root, children)
+	first.AllowMultiplePass()
+	second := first.Next()
+	assert.Equal("befcdg", names(second))
+	assert.Equal("abefcdg", names(first))
+	assert.True(second.Eq(first.Next()))
+	assert.False(second.Eq(first))
+	assert.False(second.Eq(PreorderBegin(root, children).Next()))
+	assert.Equal(7, iter.Distance[*tree](first, End[*tree]()))
+	assert.True(End[*tree]().Eq(iter.AdvanceN[*tree](first, 7)))
+
+	post := PostorderBegin(root, children)
+	mid := iter.AdvanceN[*tree](post, 3)
+	assert.Equal("cgda", names(mid))
+	assert.Equal("efbcgda", names(post))
+	assert.Equal("gda", names(mid.Next()))
+	assert.True(mid.Next().Eq(iter.AdvanceN[*tree](post, 4)))
+	assert.Equal(4, iter.Distance[*tree](mid, End[*tree]()))
+}
+
+func TestGraph(t *testing.T) {
+	assert := assert.New(t)
+	// 1 -> 2, 3; 2 -> 4; 3 -> 4, 1; 4 -> 2, 5
+	edges := map[int][]int{1: {2, 3}, 2: {4}, 3: {4, 1}, 4: {2, 5}}
+	neighbors := func(n int) []int { return edges[n] }
+	key := func(n int) int { return n }
+
+	var dfs, bfs []int
+	algo.Copy[int](DFSBegin(1, neighbors, key), SearchEnd[int](), slices.Appender(&dfs))
+	algo.Copy[int](BFSBegin(1, neighbors, key), SearchEnd[int](), slices.Appender(&bfs))
+	assert.Equal([]int{1, 2, 4, 5, 3}, dfs)
+	assert.Equal([]int{1, 2, 3, 4, 5}, bfs)
+
+	var fromFive []int
+	algo.Copy[int](DFSBegin(5, neighbors, key), SearchEnd[int](), slices.Appender(&fromFive))
+	assert.Equal([]int{5}, fromFive)
+
+	// graph iterators are single-pass: copies share the search state
+	it := BFSBegin(1, neighbors, key)
+	cp := it
+	it.Next()
+	assert.Equal(2, cp.Read())
+	assert.True(cp.Eq(it))
+	assert.False(it.Eq(SearchEnd[int]()))
+}
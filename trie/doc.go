@@ -0,0 +1,7 @@
+// Package trie provides Trie, a prefix tree that maps keys to values.
+//
+// A key is a string, with one byte per edge, or a []T. The iterators visit
+// keys in lexicographic order, which for strings is the order of Go's <
+// operator, so string tries work with the sorted-range algorithms of algo,
+// such as algo.SetUnion and algo.Includes, as they are.
+package trie
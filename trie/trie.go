@@ -0,0 +1,231 @@
+package trie
+
+import (
+	"sort"
+
+	"github.com/disksing/iter/v2"
+)
+
+type node[T iter.Ordered, V any] struct {
+	parent   *node[T, V]
+	label    T
+	children []*node[T, V] // ordered by label
+	ok       bool          // whether the node holds a value
+	value    V
+}
+
+// child returns the position of the child labelled x, and whether it exists.
+func (n *node[T, V]) child(x T) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label >= x })
+	return i, i < len(n.children) && n.children[i].label == x
+}
+
+// index returns the position of n among its siblings.
+func (n *node[T, V]) index() int {
+	i, _ := n.parent.child(n.label)
+	return i
+}
+
+// skip returns the next node in preorder that is not in the subtree of n.
+func (n *node[T, V]) skip() *node[T, V] {
+	for ; n.parent != nil; n = n.parent {
+		if i := n.index(); i+1 < len(n.parent.children) {
+			return n.parent.children[i+1]
+		}
+	}
+	return nil
+}
+
+// next returns the next node in preorder.
+func (n *node[T, V]) next() *node[T, V] {
+	if len(n.children) > 0 {
+		return n.children[0]
+	}
+	return n.skip()
+}
+
+// prev returns the previous node in preorder.
+func (n *node[T, V]) prev() *node[T, V] {
+	if n.parent == nil {
+		return nil
+	}
+	i := n.index()
+	if i == 0 {
+		return n.parent
+	}
+	return n.parent.children[i-1].last()
+}
+
+// last returns the last node in preorder of the subtree of n.
+func (n *node[T, V]) last() *node[T, V] {
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	return n
+}
+
+// first returns the first node holding a value in preorder from n on.
+func first[T iter.Ordered, V any](n *node[T, V]) *node[T, V] {
+	for n != nil && !n.ok {
+		n = n.next()
+	}
+	return n
+}
+
+// Trie is a prefix tree that maps keys of type K to values of type V. A key is
+// a sequence of elements of type T.
+type Trie[K any, T iter.Ordered, V any] struct {
+	root   node[T, V]
+	len    int
+	at     func(K, int) T
+	length func(K) int
+	make   func([]T) K
+}
+
+// NewString returns an empty trie with string keys.
+func NewString[V any]() *Trie[string, byte, V] {
+	return &Trie[string, byte, V]{
+		at:     func(s string, i int) byte { return s[i] },
+		length: func(s string) int { return len(s) },
+		make:   func(b []byte) string { return string(b) },
+	}
+}
+
+// NewSlice returns an empty trie with []T keys. Keys are copied on insertion
+// and on reading, so they can be modified by the caller.
+func NewSlice[T iter.Ordered, V any]() *Trie[[]T, T, V] {
+	return &Trie[[]T, T, V]{
+		at:     func(s []T, i int) T { return s[i] },
+		length: func(s []T) int { return len(s) },
+		make:   func(s []T) []T { return s },
+	}
+}
+
+// Len returns the number of keys.
+func (t *Trie[K, T, V]) Len() int {
+	return t.len
+}
+
+// find returns the node of the longest prefix of key in the tree, and the
+// prefix length.
+func (t *Trie[K, T, V]) find(key K) (*node[T, V], int) {
+	n, m := &t.root, t.length(key)
+	for i := 0; i < m; i++ {
+		j, ok := n.child(t.at(key, i))
+		if !ok {
+			return n, i
+		}
+		n = n.children[j]
+	}
+	return n, m
+}
+
+func (t *Trie[K, T, V]) iter(n *node[T, V]) Iterator[K, T, V] {
+	return Iterator[K, T, V]{t: t, n: n}
+}
+
+// Set sets the value of key to v. It reports whether key was inserted, that
+// is, the trie did not contain it.
+func (t *Trie[K, T, V]) Set(key K, v V) bool {
+	n, i := t.find(key)
+	for m := t.length(key); i < m; i++ {
+		x := t.at(key, i)
+		j, _ := n.child(x)
+		c := &node[T, V]{parent: n, label: x}
+		n.children = append(n.children, nil)
+		copy(n.children[j+1:], n.children[j:])
+		n.children[j] = c
+		n = c
+	}
+	inserted := !n.ok
+	if inserted {
+		t.len++
+	}
+	n.ok, n.value = true, v
+	return inserted
+}
+
+// Get returns the value of key, and whether it exists.
+func (t *Trie[K, T, V]) Get(key K) (V, bool) {
+	if n, i := t.find(key); i == t.length(key) && n.ok {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether the trie contains key.
+func (t *Trie[K, T, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Find returns an iterator to key, or End() if there is no such key.
+func (t *Trie[K, T, V]) Find(key K) Iterator[K, T, V] {
+	if n, i := t.find(key); i == t.length(key) && n.ok {
+		return t.iter(n)
+	}
+	return t.End()
+}
+
+// Delete removes key. It reports whether key was found.
+func (t *Trie[K, T, V]) Delete(key K) bool {
+	n, i := t.find(key)
+	if i < t.length(key) || !n.ok {
+		return false
+	}
+	var zero V
+	n.ok, n.value = false, zero
+	t.len--
+	for n.parent != nil && !n.ok && len(n.children) == 0 {
+		p, j := n.parent, n.index()
+		p.children = append(p.children[:j], p.children[j+1:]...)
+		n.parent, n = nil, p
+	}
+	return true
+}
+
+// LongestPrefix returns an iterator to the longest key that is a prefix of
+// key, or End() if there is no such key.
+func (t *Trie[K, T, V]) LongestPrefix(key K) Iterator[K, T, V] {
+	n, _ := t.find(key)
+	for ; n != nil; n = n.parent {
+		if n.ok {
+			return t.iter(n)
+		}
+	}
+	return t.End()
+}
+
+// LowerBound returns an iterator to the first key not less than key.
+func (t *Trie[K, T, V]) LowerBound(key K) Iterator[K, T, V] {
+	n, i := t.find(key)
+	if i == t.length(key) {
+		return t.iter(first(n))
+	}
+	// The keys in the subtree of n that are not less than key are the ones
+	// under the children labelled greater than key[i].
+	if j, _ := n.child(t.at(key, i)); j < len(n.children) {
+		return t.iter(first(n.children[j]))
+	}
+	return t.iter(first(n.skip()))
+}
+
+// UpperBound returns an iterator to the first key greater than key.
+func (t *Trie[K, T, V]) UpperBound(key K) Iterator[K, T, V] {
+	if it := t.Find(key); !it.Eq(t.End()) {
+		return it.Next()
+	}
+	return t.LowerBound(key)
+}
+
+// PrefixRange returns the range [first, last) of the keys that start with
+// prefix. The range is empty if there is no such key.
+func (t *Trie[K, T, V]) PrefixRange(prefix K) (Iterator[K, T, V], Iterator[K, T, V]) {
+	n, i := t.find(prefix)
+	if i < t.length(prefix) {
+		it := t.LowerBound(prefix)
+		return it, it
+	}
+	return t.iter(first(n)), t.iter(first(n.skip()))
+}
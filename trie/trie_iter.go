@@ -0,0 +1,123 @@
+package trie
+
+import "github.com/disksing/iter/v2"
+
+// Iterator is a bidirectional iterator over the keys of a Trie in
+// lexicographic order. Deleting a key invalidates the iterators to it.
+type Iterator[K any, T iter.Ordered, V any] struct {
+	t        *Trie[K, T, V]
+	n        *node[T, V]
+	backward bool
+}
+
+// Begin returns an iterator to the smallest key.
+func (t *Trie[K, T, V]) Begin() Iterator[K, T, V] {
+	return t.iter(first(&t.root))
+}
+
+// End returns an iterator to the passed largest key.
+func (t *Trie[K, T, V]) End() Iterator[K, T, V] {
+	return t.iter(nil)
+}
+
+// RBegin returns an iterator to the largest key.
+func (t *Trie[K, T, V]) RBegin() Iterator[K, T, V] {
+	return t.End().Prev().reverse()
+}
+
+// REnd returns an iterator to the passed smallest key.
+func (t *Trie[K, T, V]) REnd() Iterator[K, T, V] {
+	return t.End().reverse()
+}
+
+func (it Iterator[K, T, V]) reverse() Iterator[K, T, V] {
+	it.backward = !it.backward
+	return it
+}
+
+func (it Iterator[K, T, V]) Eq(x Iterator[K, T, V]) bool {
+	return it.n == x.n
+}
+
+func (it Iterator[K, T, V]) AllowMultiplePass() {}
+
+func (it Iterator[K, T, V]) succ() Iterator[K, T, V] {
+	it.n = first(it.n.next())
+	return it
+}
+
+func (it Iterator[K, T, V]) pred() Iterator[K, T, V] {
+	n := it.n
+	if n == nil {
+		n = it.t.root.last()
+	} else {
+		n = n.prev()
+	}
+	for n != nil && !n.ok {
+		n = n.prev()
+	}
+	it.n = n
+	return it
+}
+
+func (it Iterator[K, T, V]) Next() Iterator[K, T, V] {
+	if it.backward {
+		return it.pred()
+	}
+	return it.succ()
+}
+
+func (it Iterator[K, T, V]) Prev() Iterator[K, T, V] {
+	if it.backward {
+		if it.n == nil {
+			return Iterator[K, T, V]{t: it.t, n: first(&it.t.root), backward: true}
+		}
+		return it.succ()
+	}
+	return it.pred()
+}
+
+// Read returns the key.
+func (it Iterator[K, T, V]) Read() K {
+	return it.Key()
+}
+
+// Key returns the key.
+func (it Iterator[K, T, V]) Key() K {
+	var s []T
+	for n := it.n; n.parent != nil; n = n.parent {
+		s = append(s, n.label)
+	}
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+	return it.t.make(s)
+}
+
+// Value returns the value of the key.
+func (it Iterator[K, T, V]) Value() V {
+	return it.n.value
+}
+
+// SetValue sets the value of the key to v.
+func (it Iterator[K, T, V]) SetValue(v V) {
+	it.n.value = v
+}
+
+// Inserter is an output iterator that inserts keys to a Trie with the zero
+// value, keeping the values of existing keys.
+type Inserter[K any, T iter.Ordered, V any] struct {
+	t *Trie[K, T, V]
+}
+
+// KeyInserter returns an OutputIter to insert keys to the Trie.
+func KeyInserter[K any, T iter.Ordered, V any](t *Trie[K, T, V]) Inserter[K, T, V] {
+	return Inserter[K, T, V]{t: t}
+}
+
+func (ins Inserter[K, T, V]) Write(key K) {
+	if !ins.t.Contains(key) {
+		var zero V
+		ins.t.Set(key, zero)
+	}
+}
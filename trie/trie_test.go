@@ -0,0 +1,157 @@
+package trie_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/disksing/iter/v2"
+	"github.com/disksing/iter/v2/algo"
+	"github.com/disksing/iter/v2/slices"
+	"github.com/disksing/iter/v2/strs"
+	. "github.com/disksing/iter/v2/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iter.BidiReader[string, Iterator[string, byte, int]] = Iterator[string, byte, int]{}
+	_ iter.OutputIter[string]                              = Inserter[string, byte, int]{}
+)
+
+func keys[K any, T iter.Ordered, V any](first, last Iterator[K, T, V]) []K {
+	ret := []K{}
+	algo.Copy[K](first, last, slices.Appender(&ret))
+	return ret
+}
+
+func TestTrie(t *testing.T) {
+	assert := assert.New(t)
+	tr := NewString[int]()
+	assert.Equal(0, tr.Len())
+	assert.True(tr.Begin().Eq(tr.End()))
+	assert.True(tr.RBegin().Eq(tr.REnd()))
+
+	for i, k := range []string{"tea", "ten", "to", "", "inn", "in", "tea"} {
+		tr.Set(k, i)
+	}
+	assert.Equal(6, tr.Len())
+	assert.False(tr.Set("to", 10))
+	v, ok := tr.Get("to")
+	assert.True(ok)
+	assert.Equal(10, v)
+	_, ok = tr.Get("te")
+	assert.False(ok)
+	assert.True(tr.Contains(""))
+	assert.False(tr.Contains("i"))
+
+	assert.Equal([]string{"", "in", "inn", "tea", "ten", "to"}, keys(tr.Begin(), tr.End()))
+	assert.Equal([]string{"to", "ten", "tea", "inn", "in", ""}, keys(tr.RBegin(), tr.REnd()))
+	assert.Equal("to", tr.End().Prev().Read())
+	assert.Equal("", tr.REnd().Prev().Read())
+	assert.Equal("ten", tr.RBegin().Next().Next().Prev().Read())
+
+	it := tr.Find("tea")
+	assert.Equal(6, it.Value())
+	it.SetValue(60)
+	assert.Equal(60, tr.Find("tea").Value())
+	assert.True(tr.Find("te").Eq(tr.End()))
+
+	assert.True(tr.Delete("inn"))
+	assert.False(tr.Delete("inn"))
+	assert.False(tr.Delete("te"))
+	assert.True(tr.Delete(""))
+	assert.Equal([]string{"in", "tea", "ten", "to"}, keys(tr.Begin(), tr.End()))
+	assert.Equal(4, tr.Len())
+}
+
+func TestPrefix(t *testing.T) {
+	assert := assert.New(t)
+	tr := NewString[string]()
+	for _, k := range []string{"/", "/api", "/api/v1", "/api/v1/users", "/static", "/apis"} {
+		tr.Set(k, k)
+	}
+	assert.Equal([]string{"/api", "/api/v1", "/api/v1/users", "/apis"}, keys(tr.PrefixRange("/api")))
+	assert.Equal([]string{"/api/v1/users"}, keys(tr.PrefixRange("/api/v1/")))
+	assert.Equal([]string{}, keys(tr.PrefixRange("/b")))
+	assert.Equal(6, len(keys(tr.PrefixRange(""))))
+	first, last := tr.PrefixRange("/api/x")
+	assert.True(first.Eq(last))
+	assert.Equal("/apis", first.Read())
+
+	assert.Equal("/api/v1", tr.LongestPrefix("/api/v1/orders").Value())
+	assert.Equal("/", tr.LongestPrefix("/index.html").Value())
+	assert.True(tr.LongestPrefix("index.html").Eq(tr.End()))
+}
+
+func TestBounds(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	randKey := func() string {
+		b := make([]byte, r.Intn(4))
+		for i := range b {
+			b[i] = "abc"[r.Intn(3)]
+		}
+		return string(b)
+	}
+	tr := NewString[struct{}]()
+	for i := 0; i < 200; i++ {
+		k := randKey()
+		if r.Intn(3) == 0 {
+			tr.Delete(k)
+		} else {
+			tr.Set(k, struct{}{})
+		}
+		want := keys(tr.Begin(), tr.End())
+		assert.True(sort.StringsAreSorted(want))
+		assert.Equal(len(want), tr.Len())
+
+		k = randKey()
+		assert.Equal(want[slices.LowerBound(want, k):], keys(tr.LowerBound(k), tr.End()))
+		assert.Equal(want[slices.UpperBound(want, k):], keys(tr.UpperBound(k), tr.End()))
+		var prefixed []string
+		for _, s := range want {
+			if strings.HasPrefix(s, k) {
+				prefixed = append(prefixed, s)
+			}
+		}
+		assert.Equal(append([]string{}, prefixed...), keys(tr.PrefixRange(k)))
+	}
+}
+
+func TestSliceKeys(t *testing.T) {
+	assert := assert.New(t)
+	tr := NewSlice[int, string]()
+	k := []int{1, 2}
+	tr.Set(k, "a")
+	k[0] = 3
+	tr.Set([]int{1}, "b")
+	tr.Set([]int{2, 0}, "c")
+	assert.Equal([][]int{{1}, {1, 2}, {2, 0}}, keys(tr.Begin(), tr.End()))
+	assert.Equal("a", tr.UpperBound([]int{1}).Value())
+	assert.False(tr.Contains(k))
+
+	less := func(x, y []int) bool {
+		return algo.LexicographicalCompare[int](slices.Begin(x), slices.End(x), slices.Begin(y), slices.End(y))
+	}
+	other := NewSlice[int, string]()
+	other.Set([]int{1, 2}, "")
+	assert.True(algo.IncludesBy[[]int](tr.Begin(), tr.End(), other.Begin(), other.End(), less))
+}
+
+func TestSetAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	text := "the quick brown fox jumps over the lazy dog"
+	a := NewString[int]()
+	algo.Copy[string](strs.FieldsBegin(text), strs.TokenEnd(text), KeyInserter(a))
+	assert.Equal(8, a.Len())
+	b := NewString[int]()
+	algo.Copy[string](strs.SplitBegin("dog,cat,fox", ","), strs.TokenEnd("dog,cat,fox"), KeyInserter(b))
+
+	var both, either []string
+	algo.SetIntersection[string](a.Begin(), a.End(), b.Begin(), b.End(), slices.Appender(&both))
+	algo.SetUnion[string](a.Begin(), a.End(), b.Begin(), b.End(), slices.Appender(&either))
+	assert.Equal([]string{"dog", "fox"}, both)
+	assert.Equal(9, len(either))
+	assert.True(sort.StringsAreSorted(either))
+}